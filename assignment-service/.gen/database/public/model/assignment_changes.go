@@ -0,0 +1,23 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type AssignmentChanges struct {
+	ID           int32 `sql:"primary_key"`
+	AssignmentID int32
+	UserID       uuid.UUID
+	Action       string
+	Changes      string
+	Version      int32
+	CreatedAt    *time.Time
+}
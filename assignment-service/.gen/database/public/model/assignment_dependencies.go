@@ -0,0 +1,18 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+import (
+	"time"
+)
+
+type AssignmentDependencies struct {
+	AssignmentID int32 `sql:"primary_key"`
+	BlockedByID  int32 `sql:"primary_key"`
+	CreatedAt    *time.Time
+}
@@ -0,0 +1,13 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+type AssignmentTags struct {
+	AssignmentID int32  `sql:"primary_key"`
+	Tag          string `sql:"primary_key"`
+}
@@ -0,0 +1,28 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type AssignmentTemplates struct {
+	ID              int32 `sql:"primary_key"`
+	UserID          uuid.UUID
+	WorkspaceID     *int32
+	Name            string
+	TitlePattern    string
+	Note            *string
+	IsImportant     bool
+	ReminderOffsets string
+	Tags            string
+	UseCount        int32
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+}
@@ -13,13 +13,20 @@ import (
 )
 
 type Assignments struct {
-	ID          int32 `sql:"primary_key"`
-	UserID      uuid.UUID
-	Title       string
-	Note        *string
-	DueDate     *time.Time
-	IsCompleted *bool
-	IsImportant *bool
-	CreatedAt   *time.Time
-	UpdatedAt   *time.Time
+	ID                    int32 `sql:"primary_key"`
+	UserID                uuid.UUID
+	Title                 string
+	Note                  *string
+	DueDate               *time.Time
+	IsCompleted           *bool
+	IsImportant           *bool
+	CreatedAt             *time.Time
+	UpdatedAt             *time.Time
+	DeletedAt             *time.Time
+	Version               int32
+	WorkspaceAssignmentID *int32
+	CompletedAt           *time.Time
+	SearchVector          *string
+	WorkspaceID           *int32
+	SearchConfig          string
 }
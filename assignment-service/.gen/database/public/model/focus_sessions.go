@@ -0,0 +1,27 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type FocusSessions struct {
+	ID                     int32 `sql:"primary_key"`
+	AssignmentID           int32
+	UserID                 uuid.UUID
+	Kind                   string
+	PlannedDurationSeconds *int32
+	StartedAt              time.Time
+	EndedAt                *time.Time
+	DurationSeconds        *int32
+	Completed              bool
+	CreatedAt              *time.Time
+	UpdatedAt              *time.Time
+}
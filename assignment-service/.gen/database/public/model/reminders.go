@@ -12,9 +12,11 @@ import (
 )
 
 type Reminders struct {
-	ID           int32 `sql:"primary_key"`
-	AssignmentID int32
-	Date         time.Time
-	CreatedAt    *time.Time
-	UpdatedAt    *time.Time
+	ID               int32 `sql:"primary_key"`
+	AssignmentID     int32
+	Date             time.Time
+	CreatedAt        *time.Time
+	UpdatedAt        *time.Time
+	BeforeDueMinutes *int32
+	FiredAt          *time.Time
 }
@@ -0,0 +1,23 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SyncChanges struct {
+	ID            int64 `sql:"primary_key"`
+	TransactionID int64
+	Entity        string
+	EntityID      int32
+	AssignmentID  int32
+	UserID        uuid.UUID
+	CreatedAt     *time.Time
+}
@@ -0,0 +1,27 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+import (
+	"time"
+)
+
+type WebhookDeliveries struct {
+	ID             int32 `sql:"primary_key"`
+	WebhookID      int32
+	Event          string
+	Payload        string
+	Status         string
+	Attempts       int32
+	NextAttemptAt  time.Time
+	ResponseStatus *int32
+	Error          *string
+	DeliveredAt    *time.Time
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+}
@@ -0,0 +1,24 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Webhooks struct {
+	ID        int32 `sql:"primary_key"`
+	UserID    uuid.UUID
+	URL       string
+	Secret    string
+	Events    string
+	IsActive  bool
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
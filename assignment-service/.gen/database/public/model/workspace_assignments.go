@@ -0,0 +1,24 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type WorkspaceAssignments struct {
+	ID          int32 `sql:"primary_key"`
+	WorkspaceID int32
+	PublishedBy uuid.UUID
+	Title       string
+	Note        *string
+	DueDate     *time.Time
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
@@ -0,0 +1,21 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Workspaces struct {
+	ID        int32 `sql:"primary_key"`
+	Name      string
+	OwnerID   uuid.UUID
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
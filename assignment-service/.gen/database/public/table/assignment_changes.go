@@ -0,0 +1,93 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var AssignmentChanges = newAssignmentChangesTable("public", "assignment_changes", "")
+
+type assignmentChangesTable struct {
+	postgres.Table
+
+	// Columns
+	ID           postgres.ColumnInteger
+	AssignmentID postgres.ColumnInteger
+	UserID       postgres.ColumnString
+	Action       postgres.ColumnString
+	Changes      postgres.ColumnString
+	Version      postgres.ColumnInteger
+	CreatedAt    postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type AssignmentChangesTable struct {
+	assignmentChangesTable
+
+	EXCLUDED assignmentChangesTable
+}
+
+// AS creates new AssignmentChangesTable with assigned alias
+func (a AssignmentChangesTable) AS(alias string) *AssignmentChangesTable {
+	return newAssignmentChangesTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new AssignmentChangesTable with assigned schema name
+func (a AssignmentChangesTable) FromSchema(schemaName string) *AssignmentChangesTable {
+	return newAssignmentChangesTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new AssignmentChangesTable with assigned table prefix
+func (a AssignmentChangesTable) WithPrefix(prefix string) *AssignmentChangesTable {
+	return newAssignmentChangesTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new AssignmentChangesTable with assigned table suffix
+func (a AssignmentChangesTable) WithSuffix(suffix string) *AssignmentChangesTable {
+	return newAssignmentChangesTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newAssignmentChangesTable(schemaName, tableName, alias string) *AssignmentChangesTable {
+	return &AssignmentChangesTable{
+		assignmentChangesTable: newAssignmentChangesTableImpl(schemaName, tableName, alias),
+		EXCLUDED:               newAssignmentChangesTableImpl("", "excluded", ""),
+	}
+}
+
+func newAssignmentChangesTableImpl(schemaName, tableName, alias string) assignmentChangesTable {
+	var (
+		IDColumn           = postgres.IntegerColumn("id")
+		AssignmentIDColumn = postgres.IntegerColumn("assignment_id")
+		UserIDColumn       = postgres.StringColumn("user_id")
+		ActionColumn       = postgres.StringColumn("action")
+		ChangesColumn      = postgres.StringColumn("changes")
+		VersionColumn      = postgres.IntegerColumn("version")
+		CreatedAtColumn    = postgres.TimestampColumn("created_at")
+		allColumns         = postgres.ColumnList{IDColumn, AssignmentIDColumn, UserIDColumn, ActionColumn, ChangesColumn, VersionColumn, CreatedAtColumn}
+		mutableColumns     = postgres.ColumnList{AssignmentIDColumn, UserIDColumn, ActionColumn, ChangesColumn, VersionColumn, CreatedAtColumn}
+	)
+
+	return assignmentChangesTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:           IDColumn,
+		AssignmentID: AssignmentIDColumn,
+		UserID:       UserIDColumn,
+		Action:       ActionColumn,
+		Changes:      ChangesColumn,
+		Version:      VersionColumn,
+		CreatedAt:    CreatedAtColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
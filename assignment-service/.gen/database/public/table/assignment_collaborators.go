@@ -0,0 +1,96 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var AssignmentCollaborators = newAssignmentCollaboratorsTable("public", "assignment_collaborators", "")
+
+type assignmentCollaboratorsTable struct {
+	postgres.Table
+
+	// Columns
+	ID           postgres.ColumnInteger
+	AssignmentID postgres.ColumnInteger
+	UserID       postgres.ColumnString
+	Role         postgres.ColumnString
+	InvitedBy    postgres.ColumnString
+	AcceptedAt   postgres.ColumnTimestampz
+	CreatedAt    postgres.ColumnTimestamp
+	UpdatedAt    postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type AssignmentCollaboratorsTable struct {
+	assignmentCollaboratorsTable
+
+	EXCLUDED assignmentCollaboratorsTable
+}
+
+// AS creates new AssignmentCollaboratorsTable with assigned alias
+func (a AssignmentCollaboratorsTable) AS(alias string) *AssignmentCollaboratorsTable {
+	return newAssignmentCollaboratorsTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new AssignmentCollaboratorsTable with assigned schema name
+func (a AssignmentCollaboratorsTable) FromSchema(schemaName string) *AssignmentCollaboratorsTable {
+	return newAssignmentCollaboratorsTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new AssignmentCollaboratorsTable with assigned table prefix
+func (a AssignmentCollaboratorsTable) WithPrefix(prefix string) *AssignmentCollaboratorsTable {
+	return newAssignmentCollaboratorsTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new AssignmentCollaboratorsTable with assigned table suffix
+func (a AssignmentCollaboratorsTable) WithSuffix(suffix string) *AssignmentCollaboratorsTable {
+	return newAssignmentCollaboratorsTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newAssignmentCollaboratorsTable(schemaName, tableName, alias string) *AssignmentCollaboratorsTable {
+	return &AssignmentCollaboratorsTable{
+		assignmentCollaboratorsTable: newAssignmentCollaboratorsTableImpl(schemaName, tableName, alias),
+		EXCLUDED:                     newAssignmentCollaboratorsTableImpl("", "excluded", ""),
+	}
+}
+
+func newAssignmentCollaboratorsTableImpl(schemaName, tableName, alias string) assignmentCollaboratorsTable {
+	var (
+		IDColumn           = postgres.IntegerColumn("id")
+		AssignmentIDColumn = postgres.IntegerColumn("assignment_id")
+		UserIDColumn       = postgres.StringColumn("user_id")
+		RoleColumn         = postgres.StringColumn("role")
+		InvitedByColumn    = postgres.StringColumn("invited_by")
+		AcceptedAtColumn   = postgres.TimestampzColumn("accepted_at")
+		CreatedAtColumn    = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn    = postgres.TimestampColumn("updated_at")
+		allColumns         = postgres.ColumnList{IDColumn, AssignmentIDColumn, UserIDColumn, RoleColumn, InvitedByColumn, AcceptedAtColumn, CreatedAtColumn, UpdatedAtColumn}
+		mutableColumns     = postgres.ColumnList{AssignmentIDColumn, UserIDColumn, RoleColumn, InvitedByColumn, AcceptedAtColumn, CreatedAtColumn, UpdatedAtColumn}
+	)
+
+	return assignmentCollaboratorsTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:           IDColumn,
+		AssignmentID: AssignmentIDColumn,
+		UserID:       UserIDColumn,
+		Role:         RoleColumn,
+		InvitedBy:    InvitedByColumn,
+		AcceptedAt:   AcceptedAtColumn,
+		CreatedAt:    CreatedAtColumn,
+		UpdatedAt:    UpdatedAtColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
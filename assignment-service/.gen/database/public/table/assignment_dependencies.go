@@ -0,0 +1,81 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var AssignmentDependencies = newAssignmentDependenciesTable("public", "assignment_dependencies", "")
+
+type assignmentDependenciesTable struct {
+	postgres.Table
+
+	// Columns
+	AssignmentID postgres.ColumnInteger
+	BlockedByID  postgres.ColumnInteger
+	CreatedAt    postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type AssignmentDependenciesTable struct {
+	assignmentDependenciesTable
+
+	EXCLUDED assignmentDependenciesTable
+}
+
+// AS creates new AssignmentDependenciesTable with assigned alias
+func (a AssignmentDependenciesTable) AS(alias string) *AssignmentDependenciesTable {
+	return newAssignmentDependenciesTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new AssignmentDependenciesTable with assigned schema name
+func (a AssignmentDependenciesTable) FromSchema(schemaName string) *AssignmentDependenciesTable {
+	return newAssignmentDependenciesTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new AssignmentDependenciesTable with assigned table prefix
+func (a AssignmentDependenciesTable) WithPrefix(prefix string) *AssignmentDependenciesTable {
+	return newAssignmentDependenciesTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new AssignmentDependenciesTable with assigned table suffix
+func (a AssignmentDependenciesTable) WithSuffix(suffix string) *AssignmentDependenciesTable {
+	return newAssignmentDependenciesTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newAssignmentDependenciesTable(schemaName, tableName, alias string) *AssignmentDependenciesTable {
+	return &AssignmentDependenciesTable{
+		assignmentDependenciesTable: newAssignmentDependenciesTableImpl(schemaName, tableName, alias),
+		EXCLUDED:                    newAssignmentDependenciesTableImpl("", "excluded", ""),
+	}
+}
+
+func newAssignmentDependenciesTableImpl(schemaName, tableName, alias string) assignmentDependenciesTable {
+	var (
+		AssignmentIDColumn = postgres.IntegerColumn("assignment_id")
+		BlockedByIDColumn  = postgres.IntegerColumn("blocked_by_id")
+		CreatedAtColumn    = postgres.TimestampColumn("created_at")
+		allColumns         = postgres.ColumnList{AssignmentIDColumn, BlockedByIDColumn, CreatedAtColumn}
+		mutableColumns     = postgres.ColumnList{CreatedAtColumn}
+	)
+
+	return assignmentDependenciesTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		AssignmentID: AssignmentIDColumn,
+		BlockedByID:  BlockedByIDColumn,
+		CreatedAt:    CreatedAtColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
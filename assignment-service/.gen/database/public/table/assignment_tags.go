@@ -0,0 +1,78 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var AssignmentTags = newAssignmentTagsTable("public", "assignment_tags", "")
+
+type assignmentTagsTable struct {
+	postgres.Table
+
+	// Columns
+	AssignmentID postgres.ColumnInteger
+	Tag          postgres.ColumnString
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type AssignmentTagsTable struct {
+	assignmentTagsTable
+
+	EXCLUDED assignmentTagsTable
+}
+
+// AS creates new AssignmentTagsTable with assigned alias
+func (a AssignmentTagsTable) AS(alias string) *AssignmentTagsTable {
+	return newAssignmentTagsTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new AssignmentTagsTable with assigned schema name
+func (a AssignmentTagsTable) FromSchema(schemaName string) *AssignmentTagsTable {
+	return newAssignmentTagsTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new AssignmentTagsTable with assigned table prefix
+func (a AssignmentTagsTable) WithPrefix(prefix string) *AssignmentTagsTable {
+	return newAssignmentTagsTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new AssignmentTagsTable with assigned table suffix
+func (a AssignmentTagsTable) WithSuffix(suffix string) *AssignmentTagsTable {
+	return newAssignmentTagsTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newAssignmentTagsTable(schemaName, tableName, alias string) *AssignmentTagsTable {
+	return &AssignmentTagsTable{
+		assignmentTagsTable: newAssignmentTagsTableImpl(schemaName, tableName, alias),
+		EXCLUDED:            newAssignmentTagsTableImpl("", "excluded", ""),
+	}
+}
+
+func newAssignmentTagsTableImpl(schemaName, tableName, alias string) assignmentTagsTable {
+	var (
+		AssignmentIDColumn = postgres.IntegerColumn("assignment_id")
+		TagColumn          = postgres.StringColumn("tag")
+		allColumns         = postgres.ColumnList{AssignmentIDColumn, TagColumn}
+		mutableColumns     = postgres.ColumnList{}
+	)
+
+	return assignmentTagsTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		AssignmentID: AssignmentIDColumn,
+		Tag:          TagColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -0,0 +1,108 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var AssignmentTemplates = newAssignmentTemplatesTable("public", "assignment_templates", "")
+
+type assignmentTemplatesTable struct {
+	postgres.Table
+
+	// Columns
+	ID              postgres.ColumnInteger
+	UserID          postgres.ColumnString
+	WorkspaceID     postgres.ColumnInteger
+	Name            postgres.ColumnString
+	TitlePattern    postgres.ColumnString
+	Note            postgres.ColumnString
+	IsImportant     postgres.ColumnBool
+	ReminderOffsets postgres.ColumnString
+	Tags            postgres.ColumnString
+	UseCount        postgres.ColumnInteger
+	CreatedAt       postgres.ColumnTimestamp
+	UpdatedAt       postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type AssignmentTemplatesTable struct {
+	assignmentTemplatesTable
+
+	EXCLUDED assignmentTemplatesTable
+}
+
+// AS creates new AssignmentTemplatesTable with assigned alias
+func (a AssignmentTemplatesTable) AS(alias string) *AssignmentTemplatesTable {
+	return newAssignmentTemplatesTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new AssignmentTemplatesTable with assigned schema name
+func (a AssignmentTemplatesTable) FromSchema(schemaName string) *AssignmentTemplatesTable {
+	return newAssignmentTemplatesTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new AssignmentTemplatesTable with assigned table prefix
+func (a AssignmentTemplatesTable) WithPrefix(prefix string) *AssignmentTemplatesTable {
+	return newAssignmentTemplatesTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new AssignmentTemplatesTable with assigned table suffix
+func (a AssignmentTemplatesTable) WithSuffix(suffix string) *AssignmentTemplatesTable {
+	return newAssignmentTemplatesTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newAssignmentTemplatesTable(schemaName, tableName, alias string) *AssignmentTemplatesTable {
+	return &AssignmentTemplatesTable{
+		assignmentTemplatesTable: newAssignmentTemplatesTableImpl(schemaName, tableName, alias),
+		EXCLUDED:                 newAssignmentTemplatesTableImpl("", "excluded", ""),
+	}
+}
+
+func newAssignmentTemplatesTableImpl(schemaName, tableName, alias string) assignmentTemplatesTable {
+	var (
+		IDColumn              = postgres.IntegerColumn("id")
+		UserIDColumn          = postgres.StringColumn("user_id")
+		WorkspaceIDColumn     = postgres.IntegerColumn("workspace_id")
+		NameColumn            = postgres.StringColumn("name")
+		TitlePatternColumn    = postgres.StringColumn("title_pattern")
+		NoteColumn            = postgres.StringColumn("note")
+		IsImportantColumn     = postgres.BoolColumn("is_important")
+		ReminderOffsetsColumn = postgres.StringColumn("reminder_offsets")
+		TagsColumn            = postgres.StringColumn("tags")
+		UseCountColumn        = postgres.IntegerColumn("use_count")
+		CreatedAtColumn       = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn       = postgres.TimestampColumn("updated_at")
+		allColumns            = postgres.ColumnList{IDColumn, UserIDColumn, WorkspaceIDColumn, NameColumn, TitlePatternColumn, NoteColumn, IsImportantColumn, ReminderOffsetsColumn, TagsColumn, UseCountColumn, CreatedAtColumn, UpdatedAtColumn}
+		mutableColumns        = postgres.ColumnList{UserIDColumn, WorkspaceIDColumn, NameColumn, TitlePatternColumn, NoteColumn, IsImportantColumn, ReminderOffsetsColumn, TagsColumn, UseCountColumn, CreatedAtColumn, UpdatedAtColumn}
+	)
+
+	return assignmentTemplatesTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:              IDColumn,
+		UserID:          UserIDColumn,
+		WorkspaceID:     WorkspaceIDColumn,
+		Name:            NameColumn,
+		TitlePattern:    TitlePatternColumn,
+		Note:            NoteColumn,
+		IsImportant:     IsImportantColumn,
+		ReminderOffsets: ReminderOffsetsColumn,
+		Tags:            TagsColumn,
+		UseCount:        UseCountColumn,
+		CreatedAt:       CreatedAtColumn,
+		UpdatedAt:       UpdatedAtColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -17,15 +17,22 @@ type assignmentsTable struct {
 	postgres.Table
 
 	// Columns
-	ID          postgres.ColumnInteger
-	UserID      postgres.ColumnString
-	Title       postgres.ColumnString
-	Note        postgres.ColumnString
-	DueDate     postgres.ColumnTimestampz
-	IsCompleted postgres.ColumnBool
-	IsImportant postgres.ColumnBool
-	CreatedAt   postgres.ColumnTimestamp
-	UpdatedAt   postgres.ColumnTimestamp
+	ID                    postgres.ColumnInteger
+	UserID                postgres.ColumnString
+	Title                 postgres.ColumnString
+	Note                  postgres.ColumnString
+	DueDate               postgres.ColumnTimestampz
+	IsCompleted           postgres.ColumnBool
+	IsImportant           postgres.ColumnBool
+	CreatedAt             postgres.ColumnTimestamp
+	UpdatedAt             postgres.ColumnTimestamp
+	DeletedAt             postgres.ColumnTimestampz
+	Version               postgres.ColumnInteger
+	WorkspaceAssignmentID postgres.ColumnInteger
+	CompletedAt           postgres.ColumnTimestampz
+	SearchVector          postgres.ColumnString
+	WorkspaceID           postgres.ColumnInteger
+	SearchConfig          postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -66,32 +73,46 @@ func newAssignmentsTable(schemaName, tableName, alias string) *AssignmentsTable
 
 func newAssignmentsTableImpl(schemaName, tableName, alias string) assignmentsTable {
 	var (
-		IDColumn          = postgres.IntegerColumn("id")
-		UserIDColumn      = postgres.StringColumn("user_id")
-		TitleColumn       = postgres.StringColumn("title")
-		NoteColumn        = postgres.StringColumn("note")
-		DueDateColumn     = postgres.TimestampzColumn("due_date")
-		IsCompletedColumn = postgres.BoolColumn("is_completed")
-		IsImportantColumn = postgres.BoolColumn("is_important")
-		CreatedAtColumn   = postgres.TimestampColumn("created_at")
-		UpdatedAtColumn   = postgres.TimestampColumn("updated_at")
-		allColumns        = postgres.ColumnList{IDColumn, UserIDColumn, TitleColumn, NoteColumn, DueDateColumn, IsCompletedColumn, IsImportantColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns    = postgres.ColumnList{UserIDColumn, TitleColumn, NoteColumn, DueDateColumn, IsCompletedColumn, IsImportantColumn, CreatedAtColumn, UpdatedAtColumn}
+		IDColumn                    = postgres.IntegerColumn("id")
+		UserIDColumn                = postgres.StringColumn("user_id")
+		TitleColumn                 = postgres.StringColumn("title")
+		NoteColumn                  = postgres.StringColumn("note")
+		DueDateColumn               = postgres.TimestampzColumn("due_date")
+		IsCompletedColumn           = postgres.BoolColumn("is_completed")
+		IsImportantColumn           = postgres.BoolColumn("is_important")
+		CreatedAtColumn             = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn             = postgres.TimestampColumn("updated_at")
+		DeletedAtColumn             = postgres.TimestampzColumn("deleted_at")
+		VersionColumn               = postgres.IntegerColumn("version")
+		WorkspaceAssignmentIDColumn = postgres.IntegerColumn("workspace_assignment_id")
+		CompletedAtColumn           = postgres.TimestampzColumn("completed_at")
+		SearchVectorColumn          = postgres.StringColumn("search_vector")
+		WorkspaceIDColumn           = postgres.IntegerColumn("workspace_id")
+		SearchConfigColumn          = postgres.StringColumn("search_config")
+		allColumns                  = postgres.ColumnList{IDColumn, UserIDColumn, TitleColumn, NoteColumn, DueDateColumn, IsCompletedColumn, IsImportantColumn, CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, VersionColumn, WorkspaceAssignmentIDColumn, CompletedAtColumn, SearchVectorColumn, WorkspaceIDColumn, SearchConfigColumn}
+		mutableColumns              = postgres.ColumnList{UserIDColumn, TitleColumn, NoteColumn, DueDateColumn, IsCompletedColumn, IsImportantColumn, CreatedAtColumn, UpdatedAtColumn, DeletedAtColumn, VersionColumn, WorkspaceAssignmentIDColumn, CompletedAtColumn, SearchVectorColumn, WorkspaceIDColumn, SearchConfigColumn}
 	)
 
 	return assignmentsTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:          IDColumn,
-		UserID:      UserIDColumn,
-		Title:       TitleColumn,
-		Note:        NoteColumn,
-		DueDate:     DueDateColumn,
-		IsCompleted: IsCompletedColumn,
-		IsImportant: IsImportantColumn,
-		CreatedAt:   CreatedAtColumn,
-		UpdatedAt:   UpdatedAtColumn,
+		ID:                    IDColumn,
+		UserID:                UserIDColumn,
+		Title:                 TitleColumn,
+		Note:                  NoteColumn,
+		DueDate:               DueDateColumn,
+		IsCompleted:           IsCompletedColumn,
+		IsImportant:           IsImportantColumn,
+		CreatedAt:             CreatedAtColumn,
+		UpdatedAt:             UpdatedAtColumn,
+		DeletedAt:             DeletedAtColumn,
+		Version:               VersionColumn,
+		WorkspaceAssignmentID: WorkspaceAssignmentIDColumn,
+		CompletedAt:           CompletedAtColumn,
+		SearchVector:          SearchVectorColumn,
+		WorkspaceID:           WorkspaceIDColumn,
+		SearchConfig:          SearchConfigColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
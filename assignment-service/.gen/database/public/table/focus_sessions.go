@@ -0,0 +1,105 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var FocusSessions = newFocusSessionsTable("public", "focus_sessions", "")
+
+type focusSessionsTable struct {
+	postgres.Table
+
+	// Columns
+	ID                     postgres.ColumnInteger
+	AssignmentID           postgres.ColumnInteger
+	UserID                 postgres.ColumnString
+	Kind                   postgres.ColumnString
+	PlannedDurationSeconds postgres.ColumnInteger
+	StartedAt              postgres.ColumnTimestampz
+	EndedAt                postgres.ColumnTimestampz
+	DurationSeconds        postgres.ColumnInteger
+	Completed              postgres.ColumnBool
+	CreatedAt              postgres.ColumnTimestamp
+	UpdatedAt              postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type FocusSessionsTable struct {
+	focusSessionsTable
+
+	EXCLUDED focusSessionsTable
+}
+
+// AS creates new FocusSessionsTable with assigned alias
+func (a FocusSessionsTable) AS(alias string) *FocusSessionsTable {
+	return newFocusSessionsTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new FocusSessionsTable with assigned schema name
+func (a FocusSessionsTable) FromSchema(schemaName string) *FocusSessionsTable {
+	return newFocusSessionsTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new FocusSessionsTable with assigned table prefix
+func (a FocusSessionsTable) WithPrefix(prefix string) *FocusSessionsTable {
+	return newFocusSessionsTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new FocusSessionsTable with assigned table suffix
+func (a FocusSessionsTable) WithSuffix(suffix string) *FocusSessionsTable {
+	return newFocusSessionsTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newFocusSessionsTable(schemaName, tableName, alias string) *FocusSessionsTable {
+	return &FocusSessionsTable{
+		focusSessionsTable: newFocusSessionsTableImpl(schemaName, tableName, alias),
+		EXCLUDED:           newFocusSessionsTableImpl("", "excluded", ""),
+	}
+}
+
+func newFocusSessionsTableImpl(schemaName, tableName, alias string) focusSessionsTable {
+	var (
+		IDColumn                     = postgres.IntegerColumn("id")
+		AssignmentIDColumn           = postgres.IntegerColumn("assignment_id")
+		UserIDColumn                 = postgres.StringColumn("user_id")
+		KindColumn                   = postgres.StringColumn("kind")
+		PlannedDurationSecondsColumn = postgres.IntegerColumn("planned_duration_seconds")
+		StartedAtColumn              = postgres.TimestampzColumn("started_at")
+		EndedAtColumn                = postgres.TimestampzColumn("ended_at")
+		DurationSecondsColumn        = postgres.IntegerColumn("duration_seconds")
+		CompletedColumn              = postgres.BoolColumn("completed")
+		CreatedAtColumn              = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn              = postgres.TimestampColumn("updated_at")
+		allColumns                   = postgres.ColumnList{IDColumn, AssignmentIDColumn, UserIDColumn, KindColumn, PlannedDurationSecondsColumn, StartedAtColumn, EndedAtColumn, DurationSecondsColumn, CompletedColumn, CreatedAtColumn, UpdatedAtColumn}
+		mutableColumns               = postgres.ColumnList{AssignmentIDColumn, UserIDColumn, KindColumn, PlannedDurationSecondsColumn, StartedAtColumn, EndedAtColumn, DurationSecondsColumn, CompletedColumn, CreatedAtColumn, UpdatedAtColumn}
+	)
+
+	return focusSessionsTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:                     IDColumn,
+		AssignmentID:           AssignmentIDColumn,
+		UserID:                 UserIDColumn,
+		Kind:                   KindColumn,
+		PlannedDurationSeconds: PlannedDurationSecondsColumn,
+		StartedAt:              StartedAtColumn,
+		EndedAt:                EndedAtColumn,
+		DurationSeconds:        DurationSecondsColumn,
+		Completed:              CompletedColumn,
+		CreatedAt:              CreatedAtColumn,
+		UpdatedAt:              UpdatedAtColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
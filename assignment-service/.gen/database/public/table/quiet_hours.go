@@ -0,0 +1,90 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var QuietHours = newQuietHoursTable("public", "quiet_hours", "")
+
+type quietHoursTable struct {
+	postgres.Table
+
+	// Columns
+	UserID    postgres.ColumnString
+	StartsAt  postgres.ColumnString
+	EndsAt    postgres.ColumnString
+	Timezone  postgres.ColumnString
+	CreatedAt postgres.ColumnTimestamp
+	UpdatedAt postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type QuietHoursTable struct {
+	quietHoursTable
+
+	EXCLUDED quietHoursTable
+}
+
+// AS creates new QuietHoursTable with assigned alias
+func (a QuietHoursTable) AS(alias string) *QuietHoursTable {
+	return newQuietHoursTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new QuietHoursTable with assigned schema name
+func (a QuietHoursTable) FromSchema(schemaName string) *QuietHoursTable {
+	return newQuietHoursTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new QuietHoursTable with assigned table prefix
+func (a QuietHoursTable) WithPrefix(prefix string) *QuietHoursTable {
+	return newQuietHoursTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new QuietHoursTable with assigned table suffix
+func (a QuietHoursTable) WithSuffix(suffix string) *QuietHoursTable {
+	return newQuietHoursTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newQuietHoursTable(schemaName, tableName, alias string) *QuietHoursTable {
+	return &QuietHoursTable{
+		quietHoursTable: newQuietHoursTableImpl(schemaName, tableName, alias),
+		EXCLUDED:        newQuietHoursTableImpl("", "excluded", ""),
+	}
+}
+
+func newQuietHoursTableImpl(schemaName, tableName, alias string) quietHoursTable {
+	var (
+		UserIDColumn    = postgres.StringColumn("user_id")
+		StartsAtColumn  = postgres.StringColumn("starts_at")
+		EndsAtColumn    = postgres.StringColumn("ends_at")
+		TimezoneColumn  = postgres.StringColumn("timezone")
+		CreatedAtColumn = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn = postgres.TimestampColumn("updated_at")
+		allColumns      = postgres.ColumnList{UserIDColumn, StartsAtColumn, EndsAtColumn, TimezoneColumn, CreatedAtColumn, UpdatedAtColumn}
+		mutableColumns  = postgres.ColumnList{StartsAtColumn, EndsAtColumn, TimezoneColumn, CreatedAtColumn, UpdatedAtColumn}
+	)
+
+	return quietHoursTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		UserID:    UserIDColumn,
+		StartsAt:  StartsAtColumn,
+		EndsAt:    EndsAtColumn,
+		Timezone:  TimezoneColumn,
+		CreatedAt: CreatedAtColumn,
+		UpdatedAt: UpdatedAtColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -17,11 +17,13 @@ type remindersTable struct {
 	postgres.Table
 
 	// Columns
-	ID           postgres.ColumnInteger
-	AssignmentID postgres.ColumnInteger
-	Date         postgres.ColumnTimestampz
-	CreatedAt    postgres.ColumnTimestamp
-	UpdatedAt    postgres.ColumnTimestamp
+	ID               postgres.ColumnInteger
+	AssignmentID     postgres.ColumnInteger
+	Date             postgres.ColumnTimestampz
+	CreatedAt        postgres.ColumnTimestamp
+	UpdatedAt        postgres.ColumnTimestamp
+	BeforeDueMinutes postgres.ColumnInteger
+	FiredAt          postgres.ColumnTimestampz
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -62,24 +64,28 @@ func newRemindersTable(schemaName, tableName, alias string) *RemindersTable {
 
 func newRemindersTableImpl(schemaName, tableName, alias string) remindersTable {
 	var (
-		IDColumn           = postgres.IntegerColumn("id")
-		AssignmentIDColumn = postgres.IntegerColumn("assignment_id")
-		DateColumn         = postgres.TimestampzColumn("date")
-		CreatedAtColumn    = postgres.TimestampColumn("created_at")
-		UpdatedAtColumn    = postgres.TimestampColumn("updated_at")
-		allColumns         = postgres.ColumnList{IDColumn, AssignmentIDColumn, DateColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns     = postgres.ColumnList{AssignmentIDColumn, DateColumn, CreatedAtColumn, UpdatedAtColumn}
+		IDColumn               = postgres.IntegerColumn("id")
+		AssignmentIDColumn     = postgres.IntegerColumn("assignment_id")
+		DateColumn             = postgres.TimestampzColumn("date")
+		CreatedAtColumn        = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn        = postgres.TimestampColumn("updated_at")
+		BeforeDueMinutesColumn = postgres.IntegerColumn("before_due_minutes")
+		FiredAtColumn          = postgres.TimestampzColumn("fired_at")
+		allColumns             = postgres.ColumnList{IDColumn, AssignmentIDColumn, DateColumn, CreatedAtColumn, UpdatedAtColumn, BeforeDueMinutesColumn, FiredAtColumn}
+		mutableColumns         = postgres.ColumnList{AssignmentIDColumn, DateColumn, CreatedAtColumn, UpdatedAtColumn, BeforeDueMinutesColumn, FiredAtColumn}
 	)
 
 	return remindersTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:           IDColumn,
-		AssignmentID: AssignmentIDColumn,
-		Date:         DateColumn,
-		CreatedAt:    CreatedAtColumn,
-		UpdatedAt:    UpdatedAtColumn,
+		ID:               IDColumn,
+		AssignmentID:     AssignmentIDColumn,
+		Date:             DateColumn,
+		CreatedAt:        CreatedAtColumn,
+		UpdatedAt:        UpdatedAtColumn,
+		BeforeDueMinutes: BeforeDueMinutesColumn,
+		FiredAt:          FiredAtColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
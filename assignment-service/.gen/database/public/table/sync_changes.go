@@ -0,0 +1,93 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var SyncChanges = newSyncChangesTable("public", "sync_changes", "")
+
+type syncChangesTable struct {
+	postgres.Table
+
+	// Columns
+	ID            postgres.ColumnInteger
+	TransactionID postgres.ColumnInteger
+	Entity        postgres.ColumnString
+	EntityID      postgres.ColumnInteger
+	AssignmentID  postgres.ColumnInteger
+	UserID        postgres.ColumnString
+	CreatedAt     postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type SyncChangesTable struct {
+	syncChangesTable
+
+	EXCLUDED syncChangesTable
+}
+
+// AS creates new SyncChangesTable with assigned alias
+func (a SyncChangesTable) AS(alias string) *SyncChangesTable {
+	return newSyncChangesTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new SyncChangesTable with assigned schema name
+func (a SyncChangesTable) FromSchema(schemaName string) *SyncChangesTable {
+	return newSyncChangesTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new SyncChangesTable with assigned table prefix
+func (a SyncChangesTable) WithPrefix(prefix string) *SyncChangesTable {
+	return newSyncChangesTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new SyncChangesTable with assigned table suffix
+func (a SyncChangesTable) WithSuffix(suffix string) *SyncChangesTable {
+	return newSyncChangesTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newSyncChangesTable(schemaName, tableName, alias string) *SyncChangesTable {
+	return &SyncChangesTable{
+		syncChangesTable: newSyncChangesTableImpl(schemaName, tableName, alias),
+		EXCLUDED:         newSyncChangesTableImpl("", "excluded", ""),
+	}
+}
+
+func newSyncChangesTableImpl(schemaName, tableName, alias string) syncChangesTable {
+	var (
+		IDColumn            = postgres.IntegerColumn("id")
+		TransactionIDColumn = postgres.IntegerColumn("transaction_id")
+		EntityColumn        = postgres.StringColumn("entity")
+		EntityIDColumn      = postgres.IntegerColumn("entity_id")
+		AssignmentIDColumn  = postgres.IntegerColumn("assignment_id")
+		UserIDColumn        = postgres.StringColumn("user_id")
+		CreatedAtColumn     = postgres.TimestampColumn("created_at")
+		allColumns          = postgres.ColumnList{IDColumn, TransactionIDColumn, EntityColumn, EntityIDColumn, AssignmentIDColumn, UserIDColumn, CreatedAtColumn}
+		mutableColumns      = postgres.ColumnList{TransactionIDColumn, EntityColumn, EntityIDColumn, AssignmentIDColumn, UserIDColumn, CreatedAtColumn}
+	)
+
+	return syncChangesTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:            IDColumn,
+		TransactionID: TransactionIDColumn,
+		Entity:        EntityColumn,
+		EntityID:      EntityIDColumn,
+		AssignmentID:  AssignmentIDColumn,
+		UserID:        UserIDColumn,
+		CreatedAt:     CreatedAtColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
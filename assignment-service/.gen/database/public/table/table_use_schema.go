@@ -10,7 +10,22 @@ package table
 // UseSchema sets a new schema name for all generated table SQL builder types. It is recommended to invoke
 // this method only once at the beginning of the program.
 func UseSchema(schema string) {
+	AssignmentChanges = AssignmentChanges.FromSchema(schema)
+	AssignmentCollaborators = AssignmentCollaborators.FromSchema(schema)
+	AssignmentDependencies = AssignmentDependencies.FromSchema(schema)
+	AssignmentTags = AssignmentTags.FromSchema(schema)
+	AssignmentTemplates = AssignmentTemplates.FromSchema(schema)
 	Assignments = Assignments.FromSchema(schema)
+	Attachments = Attachments.FromSchema(schema)
+	CalendarFeeds = CalendarFeeds.FromSchema(schema)
+	FocusSessions = FocusSessions.FromSchema(schema)
+	QuietHours = QuietHours.FromSchema(schema)
 	Reminders = Reminders.FromSchema(schema)
 	SchemaMigrations = SchemaMigrations.FromSchema(schema)
+	SyncChanges = SyncChanges.FromSchema(schema)
+	WebhookDeliveries = WebhookDeliveries.FromSchema(schema)
+	Webhooks = Webhooks.FromSchema(schema)
+	WorkspaceAssignments = WorkspaceAssignments.FromSchema(schema)
+	WorkspaceMembers = WorkspaceMembers.FromSchema(schema)
+	Workspaces = Workspaces.FromSchema(schema)
 }
@@ -0,0 +1,108 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var WebhookDeliveries = newWebhookDeliveriesTable("public", "webhook_deliveries", "")
+
+type webhookDeliveriesTable struct {
+	postgres.Table
+
+	// Columns
+	ID             postgres.ColumnInteger
+	WebhookID      postgres.ColumnInteger
+	Event          postgres.ColumnString
+	Payload        postgres.ColumnString
+	Status         postgres.ColumnString
+	Attempts       postgres.ColumnInteger
+	NextAttemptAt  postgres.ColumnTimestampz
+	ResponseStatus postgres.ColumnInteger
+	Error          postgres.ColumnString
+	DeliveredAt    postgres.ColumnTimestampz
+	CreatedAt      postgres.ColumnTimestamp
+	UpdatedAt      postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type WebhookDeliveriesTable struct {
+	webhookDeliveriesTable
+
+	EXCLUDED webhookDeliveriesTable
+}
+
+// AS creates new WebhookDeliveriesTable with assigned alias
+func (a WebhookDeliveriesTable) AS(alias string) *WebhookDeliveriesTable {
+	return newWebhookDeliveriesTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new WebhookDeliveriesTable with assigned schema name
+func (a WebhookDeliveriesTable) FromSchema(schemaName string) *WebhookDeliveriesTable {
+	return newWebhookDeliveriesTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new WebhookDeliveriesTable with assigned table prefix
+func (a WebhookDeliveriesTable) WithPrefix(prefix string) *WebhookDeliveriesTable {
+	return newWebhookDeliveriesTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new WebhookDeliveriesTable with assigned table suffix
+func (a WebhookDeliveriesTable) WithSuffix(suffix string) *WebhookDeliveriesTable {
+	return newWebhookDeliveriesTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newWebhookDeliveriesTable(schemaName, tableName, alias string) *WebhookDeliveriesTable {
+	return &WebhookDeliveriesTable{
+		webhookDeliveriesTable: newWebhookDeliveriesTableImpl(schemaName, tableName, alias),
+		EXCLUDED:               newWebhookDeliveriesTableImpl("", "excluded", ""),
+	}
+}
+
+func newWebhookDeliveriesTableImpl(schemaName, tableName, alias string) webhookDeliveriesTable {
+	var (
+		IDColumn             = postgres.IntegerColumn("id")
+		WebhookIDColumn      = postgres.IntegerColumn("webhook_id")
+		EventColumn          = postgres.StringColumn("event")
+		PayloadColumn        = postgres.StringColumn("payload")
+		StatusColumn         = postgres.StringColumn("status")
+		AttemptsColumn       = postgres.IntegerColumn("attempts")
+		NextAttemptAtColumn  = postgres.TimestampzColumn("next_attempt_at")
+		ResponseStatusColumn = postgres.IntegerColumn("response_status")
+		ErrorColumn          = postgres.StringColumn("error")
+		DeliveredAtColumn    = postgres.TimestampzColumn("delivered_at")
+		CreatedAtColumn      = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn      = postgres.TimestampColumn("updated_at")
+		allColumns           = postgres.ColumnList{IDColumn, WebhookIDColumn, EventColumn, PayloadColumn, StatusColumn, AttemptsColumn, NextAttemptAtColumn, ResponseStatusColumn, ErrorColumn, DeliveredAtColumn, CreatedAtColumn, UpdatedAtColumn}
+		mutableColumns       = postgres.ColumnList{WebhookIDColumn, EventColumn, PayloadColumn, StatusColumn, AttemptsColumn, NextAttemptAtColumn, ResponseStatusColumn, ErrorColumn, DeliveredAtColumn, CreatedAtColumn, UpdatedAtColumn}
+	)
+
+	return webhookDeliveriesTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:             IDColumn,
+		WebhookID:      WebhookIDColumn,
+		Event:          EventColumn,
+		Payload:        PayloadColumn,
+		Status:         StatusColumn,
+		Attempts:       AttemptsColumn,
+		NextAttemptAt:  NextAttemptAtColumn,
+		ResponseStatus: ResponseStatusColumn,
+		Error:          ErrorColumn,
+		DeliveredAt:    DeliveredAtColumn,
+		CreatedAt:      CreatedAtColumn,
+		UpdatedAt:      UpdatedAtColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
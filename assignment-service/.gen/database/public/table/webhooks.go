@@ -0,0 +1,96 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var Webhooks = newWebhooksTable("public", "webhooks", "")
+
+type webhooksTable struct {
+	postgres.Table
+
+	// Columns
+	ID        postgres.ColumnInteger
+	UserID    postgres.ColumnString
+	URL       postgres.ColumnString
+	Secret    postgres.ColumnString
+	Events    postgres.ColumnString
+	IsActive  postgres.ColumnBool
+	CreatedAt postgres.ColumnTimestamp
+	UpdatedAt postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type WebhooksTable struct {
+	webhooksTable
+
+	EXCLUDED webhooksTable
+}
+
+// AS creates new WebhooksTable with assigned alias
+func (a WebhooksTable) AS(alias string) *WebhooksTable {
+	return newWebhooksTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new WebhooksTable with assigned schema name
+func (a WebhooksTable) FromSchema(schemaName string) *WebhooksTable {
+	return newWebhooksTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new WebhooksTable with assigned table prefix
+func (a WebhooksTable) WithPrefix(prefix string) *WebhooksTable {
+	return newWebhooksTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new WebhooksTable with assigned table suffix
+func (a WebhooksTable) WithSuffix(suffix string) *WebhooksTable {
+	return newWebhooksTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newWebhooksTable(schemaName, tableName, alias string) *WebhooksTable {
+	return &WebhooksTable{
+		webhooksTable: newWebhooksTableImpl(schemaName, tableName, alias),
+		EXCLUDED:      newWebhooksTableImpl("", "excluded", ""),
+	}
+}
+
+func newWebhooksTableImpl(schemaName, tableName, alias string) webhooksTable {
+	var (
+		IDColumn        = postgres.IntegerColumn("id")
+		UserIDColumn    = postgres.StringColumn("user_id")
+		URLColumn       = postgres.StringColumn("url")
+		SecretColumn    = postgres.StringColumn("secret")
+		EventsColumn    = postgres.StringColumn("events")
+		IsActiveColumn  = postgres.BoolColumn("is_active")
+		CreatedAtColumn = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn = postgres.TimestampColumn("updated_at")
+		allColumns      = postgres.ColumnList{IDColumn, UserIDColumn, URLColumn, SecretColumn, EventsColumn, IsActiveColumn, CreatedAtColumn, UpdatedAtColumn}
+		mutableColumns  = postgres.ColumnList{UserIDColumn, URLColumn, SecretColumn, EventsColumn, IsActiveColumn, CreatedAtColumn, UpdatedAtColumn}
+	)
+
+	return webhooksTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:        IDColumn,
+		UserID:    UserIDColumn,
+		URL:       URLColumn,
+		Secret:    SecretColumn,
+		Events:    EventsColumn,
+		IsActive:  IsActiveColumn,
+		CreatedAt: CreatedAtColumn,
+		UpdatedAt: UpdatedAtColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
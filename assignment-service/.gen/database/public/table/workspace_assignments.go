@@ -0,0 +1,96 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var WorkspaceAssignments = newWorkspaceAssignmentsTable("public", "workspace_assignments", "")
+
+type workspaceAssignmentsTable struct {
+	postgres.Table
+
+	// Columns
+	ID          postgres.ColumnInteger
+	WorkspaceID postgres.ColumnInteger
+	PublishedBy postgres.ColumnString
+	Title       postgres.ColumnString
+	Note        postgres.ColumnString
+	DueDate     postgres.ColumnTimestampz
+	CreatedAt   postgres.ColumnTimestamp
+	UpdatedAt   postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type WorkspaceAssignmentsTable struct {
+	workspaceAssignmentsTable
+
+	EXCLUDED workspaceAssignmentsTable
+}
+
+// AS creates new WorkspaceAssignmentsTable with assigned alias
+func (a WorkspaceAssignmentsTable) AS(alias string) *WorkspaceAssignmentsTable {
+	return newWorkspaceAssignmentsTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new WorkspaceAssignmentsTable with assigned schema name
+func (a WorkspaceAssignmentsTable) FromSchema(schemaName string) *WorkspaceAssignmentsTable {
+	return newWorkspaceAssignmentsTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new WorkspaceAssignmentsTable with assigned table prefix
+func (a WorkspaceAssignmentsTable) WithPrefix(prefix string) *WorkspaceAssignmentsTable {
+	return newWorkspaceAssignmentsTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new WorkspaceAssignmentsTable with assigned table suffix
+func (a WorkspaceAssignmentsTable) WithSuffix(suffix string) *WorkspaceAssignmentsTable {
+	return newWorkspaceAssignmentsTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newWorkspaceAssignmentsTable(schemaName, tableName, alias string) *WorkspaceAssignmentsTable {
+	return &WorkspaceAssignmentsTable{
+		workspaceAssignmentsTable: newWorkspaceAssignmentsTableImpl(schemaName, tableName, alias),
+		EXCLUDED:                  newWorkspaceAssignmentsTableImpl("", "excluded", ""),
+	}
+}
+
+func newWorkspaceAssignmentsTableImpl(schemaName, tableName, alias string) workspaceAssignmentsTable {
+	var (
+		IDColumn          = postgres.IntegerColumn("id")
+		WorkspaceIDColumn = postgres.IntegerColumn("workspace_id")
+		PublishedByColumn = postgres.StringColumn("published_by")
+		TitleColumn       = postgres.StringColumn("title")
+		NoteColumn        = postgres.StringColumn("note")
+		DueDateColumn     = postgres.TimestampzColumn("due_date")
+		CreatedAtColumn   = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn   = postgres.TimestampColumn("updated_at")
+		allColumns        = postgres.ColumnList{IDColumn, WorkspaceIDColumn, PublishedByColumn, TitleColumn, NoteColumn, DueDateColumn, CreatedAtColumn, UpdatedAtColumn}
+		mutableColumns    = postgres.ColumnList{WorkspaceIDColumn, PublishedByColumn, TitleColumn, NoteColumn, DueDateColumn, CreatedAtColumn, UpdatedAtColumn}
+	)
+
+	return workspaceAssignmentsTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:          IDColumn,
+		WorkspaceID: WorkspaceIDColumn,
+		PublishedBy: PublishedByColumn,
+		Title:       TitleColumn,
+		Note:        NoteColumn,
+		DueDate:     DueDateColumn,
+		CreatedAt:   CreatedAtColumn,
+		UpdatedAt:   UpdatedAtColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
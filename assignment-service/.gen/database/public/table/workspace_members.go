@@ -0,0 +1,87 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var WorkspaceMembers = newWorkspaceMembersTable("public", "workspace_members", "")
+
+type workspaceMembersTable struct {
+	postgres.Table
+
+	// Columns
+	ID          postgres.ColumnInteger
+	WorkspaceID postgres.ColumnInteger
+	UserID      postgres.ColumnString
+	Role        postgres.ColumnString
+	CreatedAt   postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type WorkspaceMembersTable struct {
+	workspaceMembersTable
+
+	EXCLUDED workspaceMembersTable
+}
+
+// AS creates new WorkspaceMembersTable with assigned alias
+func (a WorkspaceMembersTable) AS(alias string) *WorkspaceMembersTable {
+	return newWorkspaceMembersTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new WorkspaceMembersTable with assigned schema name
+func (a WorkspaceMembersTable) FromSchema(schemaName string) *WorkspaceMembersTable {
+	return newWorkspaceMembersTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new WorkspaceMembersTable with assigned table prefix
+func (a WorkspaceMembersTable) WithPrefix(prefix string) *WorkspaceMembersTable {
+	return newWorkspaceMembersTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new WorkspaceMembersTable with assigned table suffix
+func (a WorkspaceMembersTable) WithSuffix(suffix string) *WorkspaceMembersTable {
+	return newWorkspaceMembersTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newWorkspaceMembersTable(schemaName, tableName, alias string) *WorkspaceMembersTable {
+	return &WorkspaceMembersTable{
+		workspaceMembersTable: newWorkspaceMembersTableImpl(schemaName, tableName, alias),
+		EXCLUDED:              newWorkspaceMembersTableImpl("", "excluded", ""),
+	}
+}
+
+func newWorkspaceMembersTableImpl(schemaName, tableName, alias string) workspaceMembersTable {
+	var (
+		IDColumn          = postgres.IntegerColumn("id")
+		WorkspaceIDColumn = postgres.IntegerColumn("workspace_id")
+		UserIDColumn      = postgres.StringColumn("user_id")
+		RoleColumn        = postgres.StringColumn("role")
+		CreatedAtColumn   = postgres.TimestampColumn("created_at")
+		allColumns        = postgres.ColumnList{IDColumn, WorkspaceIDColumn, UserIDColumn, RoleColumn, CreatedAtColumn}
+		mutableColumns    = postgres.ColumnList{WorkspaceIDColumn, UserIDColumn, RoleColumn, CreatedAtColumn}
+	)
+
+	return workspaceMembersTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:          IDColumn,
+		WorkspaceID: WorkspaceIDColumn,
+		UserID:      UserIDColumn,
+		Role:        RoleColumn,
+		CreatedAt:   CreatedAtColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -0,0 +1,87 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var Workspaces = newWorkspacesTable("public", "workspaces", "")
+
+type workspacesTable struct {
+	postgres.Table
+
+	// Columns
+	ID        postgres.ColumnInteger
+	Name      postgres.ColumnString
+	OwnerID   postgres.ColumnString
+	CreatedAt postgres.ColumnTimestamp
+	UpdatedAt postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type WorkspacesTable struct {
+	workspacesTable
+
+	EXCLUDED workspacesTable
+}
+
+// AS creates new WorkspacesTable with assigned alias
+func (a WorkspacesTable) AS(alias string) *WorkspacesTable {
+	return newWorkspacesTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new WorkspacesTable with assigned schema name
+func (a WorkspacesTable) FromSchema(schemaName string) *WorkspacesTable {
+	return newWorkspacesTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new WorkspacesTable with assigned table prefix
+func (a WorkspacesTable) WithPrefix(prefix string) *WorkspacesTable {
+	return newWorkspacesTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new WorkspacesTable with assigned table suffix
+func (a WorkspacesTable) WithSuffix(suffix string) *WorkspacesTable {
+	return newWorkspacesTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newWorkspacesTable(schemaName, tableName, alias string) *WorkspacesTable {
+	return &WorkspacesTable{
+		workspacesTable: newWorkspacesTableImpl(schemaName, tableName, alias),
+		EXCLUDED:        newWorkspacesTableImpl("", "excluded", ""),
+	}
+}
+
+func newWorkspacesTableImpl(schemaName, tableName, alias string) workspacesTable {
+	var (
+		IDColumn        = postgres.IntegerColumn("id")
+		NameColumn      = postgres.StringColumn("name")
+		OwnerIDColumn   = postgres.StringColumn("owner_id")
+		CreatedAtColumn = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn = postgres.TimestampColumn("updated_at")
+		allColumns      = postgres.ColumnList{IDColumn, NameColumn, OwnerIDColumn, CreatedAtColumn, UpdatedAtColumn}
+		mutableColumns  = postgres.ColumnList{NameColumn, OwnerIDColumn, CreatedAtColumn, UpdatedAtColumn}
+	)
+
+	return workspacesTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:        IDColumn,
+		Name:      NameColumn,
+		OwnerID:   OwnerIDColumn,
+		CreatedAt: CreatedAtColumn,
+		UpdatedAt: UpdatedAtColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
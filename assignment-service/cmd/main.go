@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"os"
+	_ "time/tzdata"
 
 	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/server"
 )
@@ -1,22 +1,46 @@
 package app
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Assignment struct {
-	ID          int32      `json:"id" sql:"primary_key"`
-	UserID      uuid.UUID  `json:"user_id"`
-	Title       string     `json:"title"`
-	Note        *string    `json:"note"`
-	DueDate     *time.Time `json:"due_date"`
-	IsCompleted *bool      `json:"is_completed"`
-	IsImportant *bool      `json:"is_important"`
-	CreatedAt   *time.Time `json:"created_at"`
-	UpdatedAt   *time.Time `json:"updated_at"`
-	Reminders   []Reminder `json:"reminders,omitempty"`
+	ID                    int32      `json:"id" sql:"primary_key"`
+	UserID                uuid.UUID  `json:"user_id"`
+	Title                 string     `json:"title"`
+	Note                  *string    `json:"note"`
+	DueDate               *time.Time `json:"due_date"`
+	IsCompleted           *bool      `json:"is_completed"`
+	CompletedAt           *time.Time `json:"completed_at"`
+	IsImportant           *bool      `json:"is_important"`
+	IsBlocked             bool       `json:"is_blocked"`
+	CreatedAt             *time.Time `json:"created_at"`
+	UpdatedAt             *time.Time `json:"updated_at"`
+	DeletedAt             *time.Time `json:"deleted_at,omitempty"`
+	Version               int32      `json:"version"`
+	WorkspaceID           *int32     `json:"workspace_id"`
+	WorkspaceAssignmentID *int32     `json:"workspace_assignment_id,omitempty"`
+	SearchConfig          string     `json:"-"`
+	Reminders             []Reminder `json:"reminders,omitempty"`
+}
+
+func (a Assignment) ETag() string {
+	return fmt.Sprintf(`"%d-%d"`, a.ID, a.Version)
+}
+
+func ifMatchSatisfied(ifMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
 }
 
 type CreateAssignmentRequest struct {
@@ -30,17 +54,19 @@ type CreateAssignmentRequest struct {
 func (car *CreateAssignmentRequest) toAssignmentAndReminders(userID uuid.UUID) (Assignment, []Reminder) {
 	var reminders []Reminder
 	for _, reminder := range car.Reminders {
-		reminders = append(reminders, Reminder{
-			Date: reminder.Date,
-		})
+		reminders = append(reminders, reminder.toReminder())
 	}
 
+	isImportant := car.IsImportant != nil && *car.IsImportant
+	isCompleted := false
+
 	return Assignment{
 		UserID:      userID,
 		Title:       car.Title,
 		Note:        car.Note,
 		DueDate:     car.DueDate,
-		IsImportant: car.IsImportant,
+		IsCompleted: &isCompleted,
+		IsImportant: &isImportant,
 	}, reminders
 }
 
@@ -56,14 +82,21 @@ type UpdateAssignmentRequest struct {
 type ChangeIsCompletedRequest struct {
 	ID          int32 `json:"id" validate:"required"`
 	IsCompleted bool  `json:"is_completed"`
+	Force       bool  `json:"force"`
 }
 
 func (uar *UpdateAssignmentRequest) toAssignment() Assignment {
+	var reminders []Reminder
+	for _, reminder := range uar.Reminders {
+		reminders = append(reminders, reminder.toReminder())
+	}
+
 	return Assignment{
 		Title:       uar.Title,
 		Note:        uar.Note,
 		DueDate:     uar.DueDate,
 		IsImportant: uar.IsImportant,
 		IsCompleted: uar.IsCompleted,
+		Reminders:   reminders,
 	}
 }
@@ -0,0 +1,193 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type AssignmentChangeAction string
+
+const (
+	AssignmentChangeActionCreate     AssignmentChangeAction = "create"
+	AssignmentChangeActionUpdate     AssignmentChangeAction = "update"
+	AssignmentChangeActionComplete   AssignmentChangeAction = "complete"
+	AssignmentChangeActionUncomplete AssignmentChangeAction = "uncomplete"
+	AssignmentChangeActionDelete     AssignmentChangeAction = "delete"
+	AssignmentChangeActionRestore    AssignmentChangeAction = "restore"
+	AssignmentChangeActionUndo       AssignmentChangeAction = "undo"
+)
+
+type AssignmentChange struct {
+	ID           int32                  `json:"id" sql:"primary_key"`
+	AssignmentID int32                  `json:"assignment_id"`
+	UserID       uuid.UUID              `json:"user_id"`
+	Action       AssignmentChangeAction `json:"action"`
+	Changes      AssignmentChangeDiff   `json:"changes"`
+	Version      int32                  `json:"version"`
+	CreatedAt    *time.Time             `json:"created_at"`
+}
+
+type FieldChange struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+type AssignmentChangeDiff map[string]FieldChange
+
+func (d *AssignmentChangeDiff) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, d)
+	case string:
+		return json.Unmarshal([]byte(v), d)
+	case nil:
+		*d = AssignmentChangeDiff{}
+		return nil
+	default:
+		return errors.Errorf("cannot scan %T into AssignmentChangeDiff", value)
+	}
+}
+
+func newAssignmentChange(userID uuid.UUID, action AssignmentChangeAction, before *Assignment, after Assignment) AssignmentChange {
+	return AssignmentChange{
+		AssignmentID: after.ID,
+		UserID:       userID,
+		Action:       action,
+		Changes:      diffAssignments(before, after),
+		Version:      after.Version,
+	}
+}
+
+func assignmentChangeFields(assignment Assignment) map[string]any {
+	var dueDate *time.Time
+	if assignment.DueDate != nil {
+		d := normalizeChangeTime(*assignment.DueDate)
+		dueDate = &d
+	}
+
+	reminders := make([]reminderSnapshot, len(assignment.Reminders))
+	for i, reminder := range assignment.Reminders {
+		reminders[i] = reminderSnapshot{
+			Date:             normalizeChangeTime(reminder.Date),
+			BeforeDueMinutes: reminder.BeforeDueMinutes,
+		}
+	}
+	slices.SortFunc(reminders, func(a, b reminderSnapshot) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	return map[string]any{
+		"title":        assignment.Title,
+		"note":         assignment.Note,
+		"due_date":     dueDate,
+		"is_completed": assignment.IsCompleted != nil && *assignment.IsCompleted,
+		"is_important": assignment.IsImportant != nil && *assignment.IsImportant,
+		"reminders":    reminders,
+		"workspace_id": assignment.WorkspaceID,
+		"deleted":      assignment.DeletedAt != nil,
+	}
+}
+
+type reminderSnapshot struct {
+	Date             time.Time `json:"date"`
+	BeforeDueMinutes *int32    `json:"before_due_minutes,omitempty"`
+}
+
+func (r *reminderSnapshot) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &r.Date)
+	}
+
+	type alias reminderSnapshot
+	return json.Unmarshal(data, (*alias)(r))
+}
+
+func normalizeChangeTime(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Microsecond)
+}
+
+func diffAssignments(before *Assignment, after Assignment) AssignmentChangeDiff {
+	var beforeFields map[string]any
+	if before != nil {
+		beforeFields = assignmentChangeFields(*before)
+	}
+
+	diff := AssignmentChangeDiff{}
+	for field, value := range assignmentChangeFields(after) {
+		if before == nil && field == "deleted" {
+			continue
+		}
+
+		afterJSON, _ := json.Marshal(value)
+		beforeJSON := []byte("null")
+		if beforeFields != nil {
+			beforeJSON, _ = json.Marshal(beforeFields[field])
+		}
+
+		if bytes.Equal(beforeJSON, afterJSON) {
+			continue
+		}
+
+		diff[field] = FieldChange{Before: beforeJSON, After: afterJSON}
+	}
+
+	return diff
+}
+
+func (d AssignmentChangeDiff) revert(assignment *Assignment) error {
+	for field, change := range d {
+		var err error
+
+		switch field {
+		case "title":
+			err = json.Unmarshal(change.Before, &assignment.Title)
+		case "note":
+			assignment.Note = nil
+			err = json.Unmarshal(change.Before, &assignment.Note)
+		case "due_date":
+			assignment.DueDate = nil
+			err = json.Unmarshal(change.Before, &assignment.DueDate)
+		case "is_completed":
+			var isCompleted bool
+			err = json.Unmarshal(change.Before, &isCompleted)
+			assignment.IsCompleted = &isCompleted
+		case "is_important":
+			var isImportant bool
+			err = json.Unmarshal(change.Before, &isImportant)
+			assignment.IsImportant = &isImportant
+		case "workspace_id":
+			assignment.WorkspaceID = nil
+			err = json.Unmarshal(change.Before, &assignment.WorkspaceID)
+		case "reminders":
+			var snapshots []reminderSnapshot
+			err = json.Unmarshal(change.Before, &snapshots)
+			assignment.Reminders = make([]Reminder, len(snapshots))
+			for i, snapshot := range snapshots {
+				assignment.Reminders[i] = Reminder{
+					AssignmentID:     assignment.ID,
+					Date:             snapshot.Date,
+					BeforeDueMinutes: snapshot.BeforeDueMinutes,
+				}
+			}
+		}
+
+		if err != nil {
+			return errors.Wrapf(err, "reverting %s", field)
+		}
+	}
+
+	return nil
+}
+
+func completionChangeAction(isCompleted bool) AssignmentChangeAction {
+	if isCompleted {
+		return AssignmentChangeActionComplete
+	}
+
+	return AssignmentChangeActionUncomplete
+}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var AssignmentChangeTable = table.AssignmentChanges.AS("assignment_change")
+
+type AssignmentChangeRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewAssignmentChangeRepository(db *database.Service, tracer trace.Tracer) *AssignmentChangeRepository {
+	return &AssignmentChangeRepository{db, tracer}
+}
+
+func (r *AssignmentChangeRepository) InsertAssignmentChangesWithTransaction(ctx context.Context, tx *sql.Tx, changes []AssignmentChange) error {
+	ctx, span := r.tracer.Start(ctx, "AssignmentChangeRepository.InsertAssignmentChangesWithTransaction")
+	defer span.End()
+
+	query := AssignmentChangeTable.INSERT(
+		AssignmentChangeTable.AssignmentID,
+		AssignmentChangeTable.UserID,
+		AssignmentChangeTable.Action,
+		AssignmentChangeTable.Changes,
+		AssignmentChangeTable.Version,
+	)
+
+	for _, change := range changes {
+		diff, err := json.Marshal(change.Changes)
+		if err != nil {
+			return err
+		}
+
+		query = query.VALUES(change.AssignmentID, change.UserID, change.Action, Json(diff), change.Version)
+	}
+
+	_, err := query.ExecContext(ctx, tx)
+
+	return err
+}
+
+func (r *AssignmentChangeRepository) FindAssignmentChangesByAssignmentID(ctx context.Context, assignmentID int32) ([]AssignmentChange, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentChangeRepository.FindAssignmentChangesByAssignmentID")
+	defer span.End()
+
+	query := AssignmentChangeTable.SELECT(AssignmentChangeTable.AllColumns).
+		FROM(AssignmentChangeTable).
+		WHERE(AssignmentChangeTable.AssignmentID.EQ(Int32(assignmentID))).
+		ORDER_BY(AssignmentChangeTable.ID.DESC())
+
+	changes := []AssignmentChange{}
+	err := query.QueryContext(ctx, r.db.Pool, &changes)
+
+	return changes, err
+}
+
+func (r *AssignmentChangeRepository) FindLatestAssignmentChangeByAssignmentID(ctx context.Context, assignmentID int32) (AssignmentChange, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentChangeRepository.FindLatestAssignmentChangeByAssignmentID")
+	defer span.End()
+
+	query := AssignmentChangeTable.SELECT(AssignmentChangeTable.AllColumns).
+		FROM(AssignmentChangeTable).
+		WHERE(AssignmentChangeTable.AssignmentID.EQ(Int32(assignmentID))).
+		ORDER_BY(AssignmentChangeTable.ID.DESC()).
+		LIMIT(1)
+
+	var change AssignmentChange
+	err := query.QueryContext(ctx, r.db.Pool, &change)
+
+	return change, err
+}
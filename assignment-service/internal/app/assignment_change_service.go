@@ -0,0 +1,235 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) recordAssignmentChangesWithTransaction(ctx context.Context, tx *sql.Tx, changes []AssignmentChange) error {
+	if err := s.assignmentChangeRepository.InsertAssignmentChangesWithTransaction(ctx, tx, changes); err != nil {
+		return err
+	}
+
+	return s.publishAssignmentEventsWithTransaction(ctx, tx, changes)
+}
+
+func (s *Service) GetAssignmentHistory(ctx context.Context, userID uuid.UUID, assignmentID int32) ([]AssignmentChange, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetAssignmentHistory")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleViewer)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	changes, err := s.assignmentChangeRepository.FindAssignmentChangesByAssignmentID(ctx, assignment.ID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return changes, nil
+}
+
+func (s *Service) UndoAssignmentChange(ctx context.Context, userID uuid.UUID, assignmentID int32) (Assignment, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.UndoAssignmentChange")
+	defer span.End()
+
+	assignment, err := s.assignmentRepository.FindAssignmentByIDJoinReminders(ctx, assignmentID)
+	trashed := errors.Is(err, qrm.ErrNoRows)
+	if trashed {
+		assignment, err = s.assignmentRepository.FindTrashedAssignmentByID(ctx, assignmentID)
+	}
+	if errors.Is(err, qrm.ErrNoRows) {
+		return Assignment{}, NewClientError(http.StatusNotFound, errors.New("assignment not found"))
+	}
+	if err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if serviceErr := s.authorizeAssignment(ctx, userID, assignment, CollaboratorRoleEditor); serviceErr != nil {
+		return Assignment{}, serviceErr
+	}
+
+	change, err := s.assignmentChangeRepository.FindLatestAssignmentChangeByAssignmentID(ctx, assignment.ID)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return Assignment{}, NewClientError(http.StatusNotFound, errors.New("assignment has no changes to undo"))
+	}
+	if err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if trashed != (change.Action == AssignmentChangeActionDelete) {
+		return Assignment{}, NewClientError(http.StatusConflict, errors.New("assignment has changed since the last recorded change"))
+	}
+
+	ownerOnly := change.Action == AssignmentChangeActionCreate || change.Action == AssignmentChangeActionRestore || change.Action == AssignmentChangeActionDelete
+	if ownerOnly {
+		if serviceErr := s.authorizeAssignment(ctx, userID, assignment, CollaboratorRoleOwner); serviceErr != nil {
+			return Assignment{}, serviceErr
+		}
+	}
+
+	if serviceErr := checkUndoable(change, assignment); serviceErr != nil {
+		return Assignment{}, serviceErr
+	}
+
+	switch {
+	case trashed:
+		return s.undoAssignmentDelete(ctx, userID, assignment)
+	case ownerOnly:
+		return s.undoAssignmentCreate(ctx, userID, assignment)
+	default:
+		return s.undoAssignmentUpdate(ctx, userID, assignment, change)
+	}
+}
+
+func checkUndoable(change AssignmentChange, assignment Assignment) ServiceError {
+	if change.Action == AssignmentChangeActionUndo {
+		return NewClientError(http.StatusConflict, errors.New("last change has already been undone"))
+	}
+	if change.Version != assignment.Version {
+		return NewClientErrorWithData(http.StatusConflict, errors.New("assignment has changed since the last recorded change"), assignment)
+	}
+
+	return nil
+}
+
+func (s *Service) undoAssignmentCreate(ctx context.Context, userID uuid.UUID, assignment Assignment) (Assignment, ServiceError) {
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	deleted, err := s.assignmentRepository.SoftDeleteAssignmentWithTransaction(ctx, tx, assignment)
+	if errors.Is(err, qrm.ErrNoRows) {
+		tx.Rollback()
+		return Assignment{}, s.assignmentConflict(ctx, userID, assignment.ID)
+	}
+	if err != nil {
+		tx.Rollback()
+		return Assignment{}, NewInternalServiceError(err)
+	}
+	deleted.Reminders = assignment.Reminders
+
+	err = s.recordAssignmentChangesWithTransaction(ctx, tx, []AssignmentChange{
+		newAssignmentChange(userID, AssignmentChangeActionUndo, &assignment, deleted),
+	})
+	if err != nil {
+		tx.Rollback()
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if err := s.triggerMissionEvent(ctx, userID, newAssignmentMissionEvent(missionservice.TriggerMissionEvent_MISSION_EVENT_DELETE_ASSIGNMENT, deleted, time.Now())); err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	return deleted, nil
+}
+
+func (s *Service) undoAssignmentDelete(ctx context.Context, userID uuid.UUID, trashed Assignment) (Assignment, ServiceError) {
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if err := s.restoreAssignmentWithTransaction(ctx, tx, userID, trashed, AssignmentChangeActionUndo); err != nil {
+		tx.Rollback()
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if err := s.triggerMissionEvent(ctx, userID, newAssignmentMissionEvent(missionservice.TriggerMissionEvent_MISSION_EVENT_CREATE_ASSIGNMENT, trashed, time.Now())); err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	return s.getAuthorizedAssignmentByID(ctx, userID, trashed.ID, CollaboratorRoleViewer)
+}
+
+func (s *Service) undoAssignmentUpdate(ctx context.Context, userID uuid.UUID, assignment Assignment, change AssignmentChange) (Assignment, ServiceError) {
+	before := assignment
+	wasCompleted := assignment.IsCompleted != nil && *assignment.IsCompleted
+
+	if err := change.Changes.revert(&assignment); err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if _, ok := change.Changes["workspace_id"]; ok && assignment.WorkspaceID != nil {
+		if _, serviceErr := s.getAuthorizedWorkspaceByID(ctx, userID, *assignment.WorkspaceID, WorkspaceRoleMember); serviceErr != nil {
+			return Assignment{}, serviceErr
+		}
+	}
+
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	reverted, err := s.assignmentRepository.UpdateAssignmentWithTransaction(ctx, tx, assignment)
+	if errors.Is(err, qrm.ErrNoRows) {
+		tx.Rollback()
+		return Assignment{}, s.assignmentConflict(ctx, userID, assignment.ID)
+	}
+	if err != nil {
+		tx.Rollback()
+		return Assignment{}, NewInternalServiceError(err)
+	}
+	reverted.Reminders = assignment.Reminders
+
+	if _, ok := change.Changes["reminders"]; ok {
+		err = s.reminderRepository.DeleteRemindersByAssignmentIDWithTransaction(ctx, tx, reverted.ID)
+		if err != nil {
+			tx.Rollback()
+			return Assignment{}, NewInternalServiceError(err)
+		}
+
+		if len(assignment.Reminders) > 0 {
+			reverted.Reminders, err = s.reminderRepository.InsertRemindersWithTransaction(ctx, tx, assignment.Reminders)
+			if err != nil {
+				tx.Rollback()
+				return Assignment{}, NewInternalServiceError(err)
+			}
+		}
+	} else if _, ok := change.Changes["due_date"]; ok {
+		var serviceErr ServiceError
+		reverted.Reminders, serviceErr = s.rescheduleRelativeRemindersWithTransaction(ctx, tx, reverted)
+		if serviceErr != nil {
+			tx.Rollback()
+			return Assignment{}, serviceErr
+		}
+	}
+
+	err = s.recordAssignmentChangesWithTransaction(ctx, tx, []AssignmentChange{
+		newAssignmentChange(userID, AssignmentChangeActionUndo, &before, reverted),
+	})
+	if err != nil {
+		tx.Rollback()
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	isCompleted := reverted.IsCompleted != nil && *reverted.IsCompleted
+	if event, ok := completionMissionEvent(wasCompleted, isCompleted); ok {
+		if err := s.triggerMissionEvent(ctx, userID, newAssignmentMissionEvent(event, reverted, time.Now())); err != nil {
+			return Assignment{}, NewInternalServiceError(err)
+		}
+	}
+
+	return reverted, nil
+}
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"slices"
+	"time"
+)
+
+type AssignmentDependency struct {
+	AssignmentID int32      `json:"assignment_id" sql:"primary_key"`
+	BlockedByID  int32      `json:"blocked_by_id" sql:"primary_key"`
+	CreatedAt    *time.Time `json:"created_at"`
+}
+
+type CreateAssignmentDependencyRequest struct {
+	BlockedByID int32 `json:"blocked_by_id" validate:"required,min=1"`
+}
+
+func dependencyCreatesCycle(dependencies []AssignmentDependency, assignmentID int32, blockedByID int32) bool {
+	if assignmentID == blockedByID {
+		return true
+	}
+
+	prerequisites := make(map[int32][]int32)
+	for _, dependency := range dependencies {
+		prerequisites[dependency.AssignmentID] = append(prerequisites[dependency.AssignmentID], dependency.BlockedByID)
+	}
+
+	visited := map[int32]bool{}
+	stack := []int32{blockedByID}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current == assignmentID {
+			return true
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		stack = append(stack, prerequisites[current]...)
+	}
+
+	return false
+}
+
+func orderByDependencies(assignments []Assignment, dependencies []AssignmentDependency) []Assignment {
+	pending := make(map[int32]Assignment, len(assignments))
+	for _, assignment := range assignments {
+		if assignment.IsCompleted == nil || !*assignment.IsCompleted {
+			pending[assignment.ID] = assignment
+		}
+	}
+
+	blockers := make(map[int32]int, len(pending))
+	dependents := make(map[int32][]int32)
+	for _, dependency := range dependencies {
+		if _, ok := pending[dependency.AssignmentID]; !ok {
+			continue
+		}
+		if _, ok := pending[dependency.BlockedByID]; !ok {
+			continue
+		}
+		blockers[dependency.AssignmentID]++
+		dependents[dependency.BlockedByID] = append(dependents[dependency.BlockedByID], dependency.AssignmentID)
+	}
+
+	var layer []Assignment
+	for id, assignment := range pending {
+		if blockers[id] == 0 {
+			layer = append(layer, assignment)
+		}
+	}
+
+	ordered := make([]Assignment, 0, len(pending))
+	for len(layer) > 0 {
+		slices.SortFunc(layer, compareNextAssignments)
+		ordered = append(ordered, layer...)
+
+		var next []Assignment
+		for _, assignment := range layer {
+			for _, dependentID := range dependents[assignment.ID] {
+				blockers[dependentID]--
+				if blockers[dependentID] == 0 {
+					next = append(next, pending[dependentID])
+				}
+			}
+		}
+		layer = next
+	}
+
+	return ordered
+}
+
+func compareNextAssignments(a, b Assignment) int {
+	switch {
+	case a.DueDate != nil && b.DueDate == nil:
+		return -1
+	case a.DueDate == nil && b.DueDate != nil:
+		return 1
+	case a.DueDate != nil && !a.DueDate.Equal(*b.DueDate):
+		return a.DueDate.Compare(*b.DueDate)
+	}
+
+	aImportant := a.IsImportant != nil && *a.IsImportant
+	bImportant := b.IsImportant != nil && *b.IsImportant
+	if aImportant != bImportant {
+		if aImportant {
+			return -1
+		}
+		return 1
+	}
+
+	return int(a.ID - b.ID)
+}
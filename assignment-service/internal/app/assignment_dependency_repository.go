@@ -0,0 +1,127 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var (
+	AssignmentDependencyTable = table.AssignmentDependencies.AS("assignment_dependency")
+	PrerequisiteTable         = table.Assignments.AS("prerequisite")
+)
+
+func assignmentIsBlocked() BoolExpression {
+	return EXISTS(
+		AssignmentDependencyTable.SELECT(AssignmentDependencyTable.BlockedByID).
+			FROM(AssignmentDependencyTable.INNER_JOIN(PrerequisiteTable, PrerequisiteTable.ID.EQ(AssignmentDependencyTable.BlockedByID))).
+			WHERE(
+				AssignmentDependencyTable.AssignmentID.EQ(AssignmentTable.ID).
+					AND(PrerequisiteTable.IsCompleted.IS_NOT_TRUE()).
+					AND(PrerequisiteTable.DeletedAt.IS_NULL()),
+			),
+	)
+}
+
+type AssignmentDependencyRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewAssignmentDependencyRepository(db *database.Service, tracer trace.Tracer) *AssignmentDependencyRepository {
+	return &AssignmentDependencyRepository{db, tracer}
+}
+
+func (r *AssignmentDependencyRepository) LockDependenciesByUserIDWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID) error {
+	ctx, span := r.tracer.Start(ctx, "AssignmentDependencyRepository.LockDependenciesByUserIDWithTransaction")
+	defer span.End()
+
+	query := RawStatement("SELECT pg_advisory_xact_lock(hashtext(#userID))", RawArgs{"#userID": userID.String()})
+
+	_, err := query.ExecContext(ctx, tx)
+
+	return err
+}
+
+func (r *AssignmentDependencyRepository) FindDependenciesByUserIDWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID) ([]AssignmentDependency, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentDependencyRepository.FindDependenciesByUserIDWithTransaction")
+	defer span.End()
+
+	query := AssignmentDependencyTable.SELECT(AssignmentDependencyTable.AllColumns).
+		FROM(AssignmentDependencyTable.INNER_JOIN(AssignmentTable, AssignmentTable.ID.EQ(AssignmentDependencyTable.AssignmentID))).
+		WHERE(AssignmentTable.UserID.EQ(UUID(userID)))
+
+	dependencies := []AssignmentDependency{}
+	err := query.QueryContext(ctx, tx, &dependencies)
+
+	return dependencies, err
+}
+
+func (r *AssignmentDependencyRepository) FindDependenciesByUserID(ctx context.Context, userID uuid.UUID) ([]AssignmentDependency, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentDependencyRepository.FindDependenciesByUserID")
+	defer span.End()
+
+	query := AssignmentDependencyTable.SELECT(AssignmentDependencyTable.AllColumns).
+		FROM(AssignmentDependencyTable.INNER_JOIN(AssignmentTable, AssignmentTable.ID.EQ(AssignmentDependencyTable.AssignmentID))).
+		WHERE(AssignmentTable.UserID.EQ(UUID(userID)))
+
+	dependencies := []AssignmentDependency{}
+	err := query.QueryContext(ctx, r.db.Pool, &dependencies)
+
+	return dependencies, err
+}
+
+func (r *AssignmentDependencyRepository) FindPrerequisitesByAssignmentID(ctx context.Context, assignmentID int32) ([]Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentDependencyRepository.FindPrerequisitesByAssignmentID")
+	defer span.End()
+
+	prerequisiteIDs := AssignmentDependencyTable.SELECT(AssignmentDependencyTable.BlockedByID).
+		FROM(AssignmentDependencyTable).
+		WHERE(AssignmentDependencyTable.AssignmentID.EQ(Int32(assignmentID)))
+
+	query := AssignmentTable.SELECT(AssignmentProjections).
+		FROM(AssignmentTable).
+		WHERE(AssignmentTable.ID.IN(prerequisiteIDs).AND(AssignmentTable.DeletedAt.IS_NULL())).
+		ORDER_BY(AssignmentTable.ID.ASC())
+
+	prerequisites := []Assignment{}
+	err := query.QueryContext(ctx, r.db.Pool, &prerequisites)
+
+	return prerequisites, err
+}
+
+func (r *AssignmentDependencyRepository) InsertDependencyWithTransaction(ctx context.Context, tx *sql.Tx, dependency AssignmentDependency) (AssignmentDependency, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentDependencyRepository.InsertDependencyWithTransaction")
+	defer span.End()
+
+	query := AssignmentDependencyTable.INSERT(AssignmentDependencyTable.AssignmentID, AssignmentDependencyTable.BlockedByID).
+		VALUES(dependency.AssignmentID, dependency.BlockedByID).
+		RETURNING(AssignmentDependencyTable.AllColumns)
+
+	var inserted AssignmentDependency
+	err := query.QueryContext(ctx, tx, &inserted)
+
+	return inserted, err
+}
+
+func (r *AssignmentDependencyRepository) DeleteDependency(ctx context.Context, assignmentID int32, blockedByID int32) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentDependencyRepository.DeleteDependency")
+	defer span.End()
+
+	query := AssignmentDependencyTable.DELETE().
+		WHERE(AssignmentDependencyTable.AssignmentID.EQ(Int32(assignmentID)).AND(AssignmentDependencyTable.BlockedByID.EQ(Int32(blockedByID))))
+
+	result, err := query.ExecContext(ctx, r.db.Pool)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,149 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) checkAssignmentNotBlocked(ctx context.Context, before Assignment, isCompleted *bool, force bool) ServiceError {
+	wasCompleted := before.IsCompleted != nil && *before.IsCompleted
+	if force || wasCompleted || isCompleted == nil || !*isCompleted || !before.IsBlocked {
+		return nil
+	}
+
+	prerequisites, err := s.assignmentDependencyRepository.FindPrerequisitesByAssignmentID(ctx, before.ID)
+	if err != nil {
+		return NewInternalServiceError(err)
+	}
+
+	prerequisites = slices.DeleteFunc(prerequisites, func(prerequisite Assignment) bool {
+		return prerequisite.IsCompleted != nil && *prerequisite.IsCompleted
+	})
+
+	return NewClientErrorWithData(http.StatusConflict, errors.New("assignment is blocked by incomplete prerequisites"), prerequisites)
+}
+
+func (s *Service) GetAssignmentDependencies(ctx context.Context, userID uuid.UUID, assignmentID int32) ([]Assignment, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetAssignmentDependencies")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleViewer)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	prerequisites, err := s.assignmentDependencyRepository.FindPrerequisitesByAssignmentID(ctx, assignment.ID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return prerequisites, nil
+}
+
+func (s *Service) AddAssignmentDependency(ctx context.Context, userID uuid.UUID, assignmentID int32, request CreateAssignmentDependencyRequest) (AssignmentDependency, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.AddAssignmentDependency")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleEditor)
+	if serviceErr != nil {
+		return AssignmentDependency{}, serviceErr
+	}
+
+	prerequisite, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, request.BlockedByID, CollaboratorRoleViewer)
+	if serviceErr != nil {
+		return AssignmentDependency{}, serviceErr
+	}
+	if prerequisite.UserID != assignment.UserID {
+		return AssignmentDependency{}, NewClientErrorWithData(http.StatusUnprocessableEntity, errors.New("prerequisite belongs to another user"), map[string]string{
+			"BlockedByID": "The BlockedByID field must reference an assignment of the same owner",
+		})
+	}
+
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return AssignmentDependency{}, NewInternalServiceError(err)
+	}
+
+	if err := s.assignmentDependencyRepository.LockDependenciesByUserIDWithTransaction(ctx, tx, assignment.UserID); err != nil {
+		tx.Rollback()
+		return AssignmentDependency{}, NewInternalServiceError(err)
+	}
+
+	dependencies, err := s.assignmentDependencyRepository.FindDependenciesByUserIDWithTransaction(ctx, tx, assignment.UserID)
+	if err != nil {
+		tx.Rollback()
+		return AssignmentDependency{}, NewInternalServiceError(err)
+	}
+
+	dependency := AssignmentDependency{AssignmentID: assignment.ID, BlockedByID: prerequisite.ID}
+	if slices.ContainsFunc(dependencies, func(d AssignmentDependency) bool {
+		return d.AssignmentID == dependency.AssignmentID && d.BlockedByID == dependency.BlockedByID
+	}) {
+		tx.Rollback()
+		return AssignmentDependency{}, NewClientError(http.StatusConflict, errors.New("dependency already exists"))
+	}
+
+	if dependencyCreatesCycle(dependencies, dependency.AssignmentID, dependency.BlockedByID) {
+		tx.Rollback()
+		return AssignmentDependency{}, NewClientErrorWithData(http.StatusUnprocessableEntity, errors.New("dependency would create a cycle"), map[string]string{
+			"BlockedByID": "The BlockedByID field would create a dependency cycle",
+		})
+	}
+
+	dependency, err = s.assignmentDependencyRepository.InsertDependencyWithTransaction(ctx, tx, dependency)
+	if err != nil {
+		tx.Rollback()
+		return AssignmentDependency{}, NewInternalServiceError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return AssignmentDependency{}, NewInternalServiceError(err)
+	}
+
+	return dependency, nil
+}
+
+func (s *Service) RemoveAssignmentDependency(ctx context.Context, userID uuid.UUID, assignmentID int32, blockedByID int32) ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.RemoveAssignmentDependency")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleEditor)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	deleted, err := s.assignmentDependencyRepository.DeleteDependency(ctx, assignment.ID, blockedByID)
+	if err != nil {
+		return NewInternalServiceError(err)
+	}
+	if deleted == 0 {
+		return NewClientError(http.StatusNotFound, errors.New("dependency not found"))
+	}
+
+	return nil
+}
+
+func (s *Service) GetNextAssignments(ctx context.Context, userID uuid.UUID) ([]Assignment, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetNextAssignments")
+	defer span.End()
+
+	assignments, err := s.assignmentRepository.FindAssignmentsByUserIDJoinReminders(ctx, userID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	assignments = slices.DeleteFunc(assignments, func(assignment Assignment) bool {
+		return assignment.UserID != userID
+	})
+
+	dependencies, err := s.assignmentDependencyRepository.FindDependenciesByUserID(ctx, userID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return orderByDependencies(assignments, dependencies), nil
+}
@@ -0,0 +1,138 @@
+package app
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDependencyCreatesCycle(t *testing.T) {
+	chain := []AssignmentDependency{
+		{AssignmentID: 1, BlockedByID: 2},
+		{AssignmentID: 2, BlockedByID: 3},
+	}
+	diamond := []AssignmentDependency{
+		{AssignmentID: 1, BlockedByID: 2},
+		{AssignmentID: 1, BlockedByID: 3},
+		{AssignmentID: 2, BlockedByID: 4},
+		{AssignmentID: 3, BlockedByID: 4},
+	}
+	existingCycle := []AssignmentDependency{
+		{AssignmentID: 5, BlockedByID: 6},
+		{AssignmentID: 6, BlockedByID: 5},
+	}
+
+	tests := []struct {
+		name         string
+		dependencies []AssignmentDependency
+		assignmentID int32
+		blockedByID  int32
+		want         bool
+	}{
+		{name: "self dependency", assignmentID: 1, blockedByID: 1, want: true},
+		{name: "first dependency", assignmentID: 1, blockedByID: 2, want: false},
+		{name: "direct reverse", dependencies: chain, assignmentID: 2, blockedByID: 1, want: true},
+		{name: "transitive reverse", dependencies: chain, assignmentID: 3, blockedByID: 1, want: true},
+		{name: "redundant shortcut", dependencies: chain, assignmentID: 1, blockedByID: 3, want: false},
+		{name: "extend chain", dependencies: chain, assignmentID: 3, blockedByID: 4, want: false},
+		{name: "diamond root blocked by new assignment", dependencies: diamond, assignmentID: 4, blockedByID: 5, want: false},
+		{name: "diamond root blocked by its dependent", dependencies: diamond, assignmentID: 4, blockedByID: 1, want: true},
+		{name: "diamond sibling", dependencies: diamond, assignmentID: 2, blockedByID: 3, want: false},
+		{name: "existing cycle elsewhere", dependencies: existingCycle, assignmentID: 1, blockedByID: 5, want: false},
+		{name: "existing cycle reached from the new edge", dependencies: append(slices.Clone(existingCycle), AssignmentDependency{AssignmentID: 6, BlockedByID: 1}), assignmentID: 1, blockedByID: 5, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dependencyCreatesCycle(tt.dependencies, tt.assignmentID, tt.blockedByID); got != tt.want {
+				t.Errorf("dependencyCreatesCycle(%d, %d) = %v, want %v", tt.assignmentID, tt.blockedByID, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderByDependencies(t *testing.T) {
+	day := func(d int) *time.Time {
+		date := time.Date(2026, time.March, d, 9, 0, 0, 0, time.UTC)
+		return &date
+	}
+	yes := true
+
+	tests := []struct {
+		name         string
+		assignments  []Assignment
+		dependencies []AssignmentDependency
+		want         []int32
+	}{
+		{
+			name:        "independent assignments by due date, importance and id",
+			assignments: []Assignment{{ID: 1}, {ID: 2, DueDate: day(9)}, {ID: 3, DueDate: day(8)}, {ID: 4, DueDate: day(9), IsImportant: &yes}, {ID: 5, IsImportant: &yes}},
+			want:        []int32{3, 4, 2, 5, 1},
+		},
+		{
+			name:         "prerequisite first even when due later",
+			assignments:  []Assignment{{ID: 1, DueDate: day(8)}, {ID: 2, DueDate: day(20)}},
+			dependencies: []AssignmentDependency{{AssignmentID: 1, BlockedByID: 2}},
+			want:         []int32{2, 1},
+		},
+		{
+			name:        "chain",
+			assignments: []Assignment{{ID: 1}, {ID: 2}, {ID: 3}},
+			dependencies: []AssignmentDependency{
+				{AssignmentID: 1, BlockedByID: 2},
+				{AssignmentID: 2, BlockedByID: 3},
+			},
+			want: []int32{3, 2, 1},
+		},
+		{
+			name:        "diamond",
+			assignments: []Assignment{{ID: 1}, {ID: 2, DueDate: day(12)}, {ID: 3, DueDate: day(10)}, {ID: 4}},
+			dependencies: []AssignmentDependency{
+				{AssignmentID: 1, BlockedByID: 2},
+				{AssignmentID: 1, BlockedByID: 3},
+				{AssignmentID: 2, BlockedByID: 4},
+				{AssignmentID: 3, BlockedByID: 4},
+			},
+			want: []int32{4, 3, 2, 1},
+		},
+		{
+			name:         "completed prerequisite no longer blocks",
+			assignments:  []Assignment{{ID: 1, DueDate: day(8)}, {ID: 2, IsCompleted: &yes}, {ID: 3, DueDate: day(9)}},
+			dependencies: []AssignmentDependency{{AssignmentID: 1, BlockedByID: 2}},
+			want:         []int32{1, 3},
+		},
+		{
+			name:         "prerequisite outside the list is ignored",
+			assignments:  []Assignment{{ID: 1}},
+			dependencies: []AssignmentDependency{{AssignmentID: 1, BlockedByID: 99}},
+			want:         []int32{1},
+		},
+		{
+			name:        "assignments in a cycle are left out",
+			assignments: []Assignment{{ID: 1}, {ID: 2}, {ID: 3}},
+			dependencies: []AssignmentDependency{
+				{AssignmentID: 1, BlockedByID: 2},
+				{AssignmentID: 2, BlockedByID: 1},
+			},
+			want: []int32{3},
+		},
+		{
+			name: "empty",
+			want: []int32{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered := orderByDependencies(tt.assignments, tt.dependencies)
+
+			got := make([]int32, len(ordered))
+			for i, assignment := range ordered {
+				got[i] = assignment.ID
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type PatchReminderRequest struct {
+	Date             *time.Time `json:"date" validate:"required_without=BeforeDueMinutes,excluded_with=BeforeDueMinutes"`
+	BeforeDueMinutes *int32     `json:"before_due_minutes" validate:"omitempty,min=0,max=525600"`
+}
+
+func (r PatchReminderRequest) matches(reminder Reminder) bool {
+	if r.BeforeDueMinutes != nil {
+		return reminder.relative() && *reminder.BeforeDueMinutes == *r.BeforeDueMinutes
+	}
+
+	return !reminder.relative() && reminder.Date.Equal(*r.Date)
+}
+
+type AssignmentMergePatch struct {
+	Title       *string                `json:"title" validate:"omitempty,min=1,max=255"`
+	Note        *string                `json:"note"`
+	DueDate     *time.Time             `json:"due_date"`
+	IsImportant *bool                  `json:"is_important"`
+	IsCompleted *bool                  `json:"is_completed"`
+	Reminders   []PatchReminderRequest `json:"reminders" validate:"omitempty,dive"`
+	present     map[string]bool
+}
+
+var assignmentMergePatchFields = map[string]bool{
+	"title":        true,
+	"note":         true,
+	"due_date":     true,
+	"is_important": true,
+	"is_completed": true,
+	"reminders":    true,
+}
+
+func (p *AssignmentMergePatch) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	p.present = make(map[string]bool, len(fields))
+	for name := range fields {
+		if !assignmentMergePatchFields[name] {
+			return fmt.Errorf("unknown field %q", name)
+		}
+		p.present[name] = true
+	}
+
+	type alias AssignmentMergePatch
+	return json.Unmarshal(data, (*alias)(p))
+}
+
+func (p *AssignmentMergePatch) has(field string) bool {
+	return p.present[field]
+}
+
+func (p *AssignmentMergePatch) nullFieldErrors() map[string]string {
+	fieldErrors := make(map[string]string)
+
+	if p.has("title") && p.Title == nil {
+		fieldErrors["Title"] = "The Title field cannot be null"
+	}
+	if p.has("due_date") && p.DueDate == nil {
+		fieldErrors["DueDate"] = "The DueDate field cannot be null"
+	}
+	if p.has("is_important") && p.IsImportant == nil {
+		fieldErrors["IsImportant"] = "The IsImportant field cannot be null"
+	}
+	if p.has("is_completed") && p.IsCompleted == nil {
+		fieldErrors["IsCompleted"] = "The IsCompleted field cannot be null"
+	}
+
+	return fieldErrors
+}
+
+func (p *AssignmentMergePatch) apply(assignment *Assignment) {
+	if p.has("title") {
+		assignment.Title = *p.Title
+	}
+	if p.has("note") {
+		assignment.Note = p.Note
+	}
+	if p.has("due_date") {
+		assignment.DueDate = p.DueDate
+	}
+	if p.has("is_important") {
+		assignment.IsImportant = p.IsImportant
+	}
+	if p.has("is_completed") {
+		assignment.IsCompleted = p.IsCompleted
+	}
+}
+
+func (p *AssignmentMergePatch) diffReminders(existing []Reminder) (kept []Reminder, removed []int32, added []Reminder) {
+	matched := make([]bool, len(existing))
+
+	for _, reminder := range p.Reminders {
+		found := false
+		for i, e := range existing {
+			if !matched[i] && reminder.matches(e) {
+				matched[i] = true
+				kept = append(kept, e)
+				found = true
+				break
+			}
+		}
+		if !found {
+			added = append(added, CreateReminderRequest(reminder).toReminder())
+		}
+	}
+
+	for i, e := range existing {
+		if !matched[i] {
+			removed = append(removed, e.ID)
+		}
+	}
+
+	return kept, removed, added
+}
@@ -0,0 +1,140 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) PatchAssignmentByID(ctx context.Context, userID uuid.UUID, assignmentID int32, ifMatch string, patch AssignmentMergePatch) (Assignment, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.PatchAssignmentByID")
+	defer span.End()
+
+	if fieldErrors := patch.nullFieldErrors(); len(fieldErrors) > 0 {
+		return Assignment{}, NewClientErrorWithData(http.StatusUnprocessableEntity, errors.New("merge patch removes a required field"), fieldErrors)
+	}
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleEditor)
+	if serviceErr != nil {
+		return Assignment{}, serviceErr
+	}
+
+	if serviceErr := checkAssignmentPrecondition(assignment, ifMatch); serviceErr != nil {
+		return Assignment{}, serviceErr
+	}
+
+	before := assignment
+	wasCompleted := assignment.IsCompleted != nil && *assignment.IsCompleted
+	existingReminders := assignment.Reminders
+
+	patch.apply(&assignment)
+
+	if serviceErr := s.checkAssignmentNotBlocked(ctx, before, assignment.IsCompleted, false); serviceErr != nil {
+		return Assignment{}, serviceErr
+	}
+
+	var kept, added []Reminder
+	var removed []int32
+	if patch.has("reminders") {
+		kept, removed, added = patch.diffReminders(existingReminders)
+
+		now := time.Now()
+		for _, reminder := range added {
+			if !reminder.relative() && !reminder.Date.After(now) {
+				return Assignment{}, NewClientErrorWithData(http.StatusUnprocessableEntity, errors.New("new reminders must be in the future"), map[string]string{
+					"Reminders": "The Reminders field must only add future dates",
+				})
+			}
+		}
+
+		added, serviceErr = s.scheduleReminders(ctx, assignment.UserID, assignment.DueDate, added)
+		if serviceErr != nil {
+			return Assignment{}, serviceErr
+		}
+	}
+
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	updated, err := s.assignmentRepository.UpdateAssignmentWithTransaction(ctx, tx, assignment)
+	if errors.Is(err, qrm.ErrNoRows) {
+		tx.Rollback()
+		return Assignment{}, s.assignmentConflict(ctx, userID, assignmentID)
+	}
+	if err != nil {
+		tx.Rollback()
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	updated.Reminders = existingReminders
+	if patch.has("reminders") {
+		if len(removed) > 0 {
+			if err := s.reminderRepository.DeleteRemindersByIDsWithTransaction(ctx, tx, removed); err != nil {
+				tx.Rollback()
+				return Assignment{}, NewInternalServiceError(err)
+			}
+		}
+
+		if len(added) > 0 {
+			for i := range added {
+				added[i].AssignmentID = updated.ID
+			}
+
+			added, err = s.reminderRepository.InsertRemindersWithTransaction(ctx, tx, added)
+			if err != nil {
+				tx.Rollback()
+				return Assignment{}, NewInternalServiceError(err)
+			}
+		}
+
+		updated.Reminders = append(kept, added...)
+		sortReminders(updated.Reminders)
+	}
+
+	if patch.has("due_date") {
+		updated.Reminders, serviceErr = s.rescheduleRelativeRemindersWithTransaction(ctx, tx, updated)
+		if serviceErr != nil {
+			tx.Rollback()
+			return Assignment{}, serviceErr
+		}
+	}
+
+	err = s.recordAssignmentChangesWithTransaction(ctx, tx, []AssignmentChange{
+		newAssignmentChange(userID, AssignmentChangeActionUpdate, &before, updated),
+	})
+	if err != nil {
+		tx.Rollback()
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	isCompleted := updated.IsCompleted != nil && *updated.IsCompleted
+	if event, ok := completionMissionEvent(wasCompleted, isCompleted); ok {
+		if err := s.triggerMissionEvent(ctx, userID, newAssignmentMissionEvent(event, updated, time.Now())); err != nil {
+			return Assignment{}, NewInternalServiceError(err)
+		}
+	}
+
+	return updated, nil
+}
+
+func completionMissionEvent(wasCompleted bool, isCompleted bool) (missionservice.TriggerMissionEvent, bool) {
+	switch {
+	case !wasCompleted && isCompleted:
+		return missionservice.TriggerMissionEvent_MISSION_EVENT_DONE_ASSIGNMENT, true
+	case wasCompleted && !isCompleted:
+		return missionservice.TriggerMissionEvent_MISSION_EVENT_UNDONE_ASSIGNMENT, true
+	default:
+		return missionservice.TriggerMissionEvent_MISSION_EVENT_UNKNOWN, false
+	}
+}
@@ -0,0 +1,31 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+)
+
+func TestCompletionMissionEvent(t *testing.T) {
+	tests := []struct {
+		name         string
+		wasCompleted bool
+		isCompleted  bool
+		want         missionservice.TriggerMissionEvent
+		ok           bool
+	}{
+		{name: "completed", wasCompleted: false, isCompleted: true, want: missionservice.TriggerMissionEvent_MISSION_EVENT_DONE_ASSIGNMENT, ok: true},
+		{name: "reopened", wasCompleted: true, isCompleted: false, want: missionservice.TriggerMissionEvent_MISSION_EVENT_UNDONE_ASSIGNMENT, ok: true},
+		{name: "still completed", wasCompleted: true, isCompleted: true, want: missionservice.TriggerMissionEvent_MISSION_EVENT_UNKNOWN, ok: false},
+		{name: "still open", wasCompleted: false, isCompleted: false, want: missionservice.TriggerMissionEvent_MISSION_EVENT_UNKNOWN, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := completionMissionEvent(tt.wasCompleted, tt.isCompleted)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("completionMissionEvent(%v, %v) = %v, %v, want %v, %v", tt.wasCompleted, tt.isCompleted, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -15,7 +15,12 @@ import (
 	. "github.com/go-jet/jet/v2/postgres"
 )
 
-var AssignmentTable = table.Assignments.AS("assignment")
+var (
+	AssignmentTable   = table.Assignments.AS("assignment")
+	AssignmentColumns = AssignmentTable.AllColumns.Except(AssignmentTable.SearchVector)
+
+	AssignmentProjections = ProjectionList{AssignmentColumns, assignmentIsBlocked().AS("assignment.is_blocked")}
+)
 
 type AssignmentRepository struct {
 	db     *database.Service
@@ -30,9 +35,9 @@ func (r *AssignmentRepository) InsertAssignmentWithTransaction(ctx context.Conte
 	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.InsertAssignmentWithTransaction")
 	defer span.End()
 
-	query := AssignmentTable.INSERT(AssignmentTable.UserID, AssignmentTable.Title, AssignmentTable.Note, AssignmentTable.DueDate).
-		VALUES(assignment.UserID, assignment.Title, assignment.Note, assignment.DueDate).
-		RETURNING(AssignmentTable.AllColumns)
+	query := AssignmentTable.INSERT(AssignmentTable.UserID, AssignmentTable.Title, AssignmentTable.Note, AssignmentTable.DueDate, AssignmentTable.IsCompleted, AssignmentTable.CompletedAt, AssignmentTable.IsImportant, AssignmentTable.WorkspaceAssignmentID, AssignmentTable.SearchConfig).
+		VALUES(assignment.UserID, assignment.Title, assignment.Note, assignment.DueDate, assignment.IsCompleted, insertedCompletedAt(assignment.IsCompleted), assignment.IsImportant, assignment.WorkspaceAssignmentID, insertedSearchConfig(assignment.SearchConfig)).
+		RETURNING(AssignmentProjections)
 
 	var a Assignment
 	err := query.QueryContext(ctx, tx, &a)
@@ -42,13 +47,30 @@ func (r *AssignmentRepository) InsertAssignmentWithTransaction(ctx context.Conte
 	return a, err
 }
 
+func (r *AssignmentRepository) InsertAssignmentsWithTransaction(ctx context.Context, tx *sql.Tx, assignments []Assignment) ([]Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.InsertAssignmentsWithTransaction")
+	defer span.End()
+
+	query := AssignmentTable.INSERT(AssignmentTable.UserID, AssignmentTable.Title, AssignmentTable.Note, AssignmentTable.DueDate, AssignmentTable.IsCompleted, AssignmentTable.CompletedAt, AssignmentTable.IsImportant, AssignmentTable.WorkspaceAssignmentID, AssignmentTable.SearchConfig).
+		RETURNING(AssignmentProjections)
+
+	for _, assignment := range assignments {
+		query = query.VALUES(assignment.UserID, assignment.Title, assignment.Note, assignment.DueDate, assignment.IsCompleted, insertedCompletedAt(assignment.IsCompleted), assignment.IsImportant, assignment.WorkspaceAssignmentID, insertedSearchConfig(assignment.SearchConfig))
+	}
+
+	var insertedAssignments []Assignment
+	err := query.QueryContext(ctx, tx, &insertedAssignments)
+
+	return insertedAssignments, err
+}
+
 func (r *AssignmentRepository) FindAssignmentsByUserIDJoinReminders(ctx context.Context, userID uuid.UUID) ([]Assignment, error) {
 	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.FindAssignmentsByUserIDJoinReminders")
 	defer span.End()
 
-	query := AssignmentTable.SELECT(AssignmentTable.AllColumns, ReminderTable.AllColumns).
+	query := AssignmentTable.SELECT(AssignmentProjections, ReminderTable.AllColumns).
 		FROM(AssignmentTable.LEFT_JOIN(ReminderTable, AssignmentTable.ID.EQ(ReminderTable.AssignmentID))).
-		WHERE(AssignmentTable.UserID.EQ(UUID(userID)))
+		WHERE(assignmentVisibleTo(userID).AND(AssignmentTable.DeletedAt.IS_NULL()))
 
 	var AssignmentTable []Assignment
 	err := query.QueryContext(ctx, r.db.Pool, &AssignmentTable)
@@ -56,13 +78,64 @@ func (r *AssignmentRepository) FindAssignmentsByUserIDJoinReminders(ctx context.
 	return AssignmentTable, err
 }
 
+func (r *AssignmentRepository) FindCalendarFeedVersionByUserID(ctx context.Context, userID uuid.UUID) (CalendarFeedVersion, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.FindCalendarFeedVersionByUserID")
+	defer span.End()
+
+	query := SELECT(
+		COUNT(DISTINCT(AssignmentTable.ID)).AS("calendar_feed_version.assignments"),
+		COUNT(ReminderTable.ID).AS("calendar_feed_version.reminders"),
+		MAX(AssignmentTable.UpdatedAt).AS("calendar_feed_version.assignments_updated_at"),
+		MAX(ReminderTable.UpdatedAt).AS("calendar_feed_version.reminders_updated_at"),
+	).
+		FROM(AssignmentTable.LEFT_JOIN(ReminderTable, AssignmentTable.ID.EQ(ReminderTable.AssignmentID))).
+		WHERE(assignmentVisibleTo(userID).AND(AssignmentTable.DeletedAt.IS_NULL()))
+
+	var version CalendarFeedVersion
+	err := query.QueryContext(ctx, r.db.Pool, &version)
+
+	return version, err
+}
+
+func (r *AssignmentRepository) FindAssignmentsByUserIDJoinRemindersWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID) ([]Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.FindAssignmentsByUserIDJoinRemindersWithTransaction")
+	defer span.End()
+
+	query := AssignmentTable.SELECT(AssignmentProjections, ReminderTable.AllColumns).
+		FROM(AssignmentTable.LEFT_JOIN(ReminderTable, AssignmentTable.ID.EQ(ReminderTable.AssignmentID))).
+		WHERE(assignmentVisibleTo(userID).AND(AssignmentTable.DeletedAt.IS_NULL()))
+
+	assignments := []Assignment{}
+	err := query.QueryContext(ctx, tx, &assignments)
+
+	return assignments, err
+}
+
+func (r *AssignmentRepository) FindVisibleAssignmentsByIDsJoinRemindersWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID, assignmentIDs []int32) ([]Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.FindVisibleAssignmentsByIDsJoinRemindersWithTransaction")
+	defer span.End()
+
+	query := AssignmentTable.SELECT(AssignmentProjections, ReminderTable.AllColumns).
+		FROM(AssignmentTable.LEFT_JOIN(ReminderTable, AssignmentTable.ID.EQ(ReminderTable.AssignmentID))).
+		WHERE(
+			AssignmentTable.ID.IN(int32Expressions(assignmentIDs)...).
+				AND(assignmentVisibleTo(userID)).
+				AND(AssignmentTable.DeletedAt.IS_NULL()),
+		)
+
+	assignments := []Assignment{}
+	err := query.QueryContext(ctx, tx, &assignments)
+
+	return assignments, err
+}
+
 func (r *AssignmentRepository) FindAssignmentByIDJoinReminders(ctx context.Context, assignmentID int32) (Assignment, error) {
 	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.FindAssignmentByIDJoinReminders")
 	defer span.End()
 
-	query := AssignmentTable.SELECT(AssignmentTable.AllColumns, ReminderTable.AllColumns).
+	query := AssignmentTable.SELECT(AssignmentProjections, ReminderTable.AllColumns).
 		FROM(AssignmentTable.LEFT_JOIN(ReminderTable, AssignmentTable.ID.EQ(ReminderTable.AssignmentID))).
-		WHERE(AssignmentTable.ID.EQ(Int32(assignmentID)))
+		WHERE(AssignmentTable.ID.EQ(Int32(assignmentID)).AND(AssignmentTable.DeletedAt.IS_NULL()))
 
 	var a Assignment
 	err := query.QueryContext(ctx, r.db.Pool, &a)
@@ -74,9 +147,9 @@ func (r *AssignmentRepository) FindAssignmentByID(ctx context.Context, assignmen
 	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.FindAssignmentByID")
 	defer span.End()
 
-	query := AssignmentTable.SELECT(AssignmentTable.AllColumns).
+	query := AssignmentTable.SELECT(AssignmentProjections).
 		FROM(AssignmentTable).
-		WHERE(AssignmentTable.ID.EQ(Int32(assignmentID)))
+		WHERE(AssignmentTable.ID.EQ(Int32(assignmentID)).AND(AssignmentTable.DeletedAt.IS_NULL()))
 
 	var a Assignment
 	err := query.QueryContext(ctx, r.db.Pool, &a)
@@ -99,13 +172,282 @@ func (r *AssignmentRepository) UpdateAssignmentWithTransaction(ctx context.Conte
 	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.UpdateAssignmentWithTransaction")
 	defer span.End()
 
-	query := AssignmentTable.UPDATE(AssignmentTable.Title, AssignmentTable.Note, AssignmentTable.DueDate, AssignmentTable.IsCompleted, AssignmentTable.IsImportant, AssignmentTable.UpdatedAt).
-		SET(assignment.Title, assignment.Note, assignment.DueDate, assignment.IsCompleted, assignment.IsImportant, time.Now()).
-		WHERE(AssignmentTable.ID.EQ(Int32(assignment.ID))).
-		RETURNING(AssignmentTable.AllColumns)
+	query := AssignmentTable.UPDATE(AssignmentTable.Title, AssignmentTable.Note, AssignmentTable.DueDate, AssignmentTable.IsCompleted, AssignmentTable.CompletedAt, AssignmentTable.IsImportant, AssignmentTable.WorkspaceID, AssignmentTable.UpdatedAt, AssignmentTable.Version).
+		SET(assignment.Title, assignment.Note, assignment.DueDate, assignment.IsCompleted, updatedCompletedAt(assignment.IsCompleted), assignment.IsImportant, assignment.WorkspaceID, time.Now(), AssignmentTable.Version.ADD(Int(1))).
+		WHERE(AssignmentTable.ID.EQ(Int32(assignment.ID)).
+			AND(AssignmentTable.Version.EQ(Int32(assignment.Version))).
+			AND(AssignmentTable.DeletedAt.IS_NULL())).
+		RETURNING(AssignmentProjections)
+
+	var a Assignment
+	err := query.QueryContext(ctx, tx, &a)
+
+	return a, err
+}
+
+func (r *AssignmentRepository) FindAssignmentsByIDs(ctx context.Context, assignmentIDs []int32) ([]Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.FindAssignmentsByIDs")
+	defer span.End()
+
+	query := AssignmentTable.SELECT(AssignmentProjections).
+		FROM(AssignmentTable).
+		WHERE(AssignmentTable.ID.IN(int32Expressions(assignmentIDs)...).AND(AssignmentTable.DeletedAt.IS_NULL()))
+
+	assignments := []Assignment{}
+	err := query.QueryContext(ctx, r.db.Pool, &assignments)
+
+	return assignments, err
+}
+
+func (r *AssignmentRepository) FindAssignmentsByIDsForUpdateWithTransaction(ctx context.Context, tx *sql.Tx, assignmentIDs []int32) ([]Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.FindAssignmentsByIDsForUpdateWithTransaction")
+	defer span.End()
+
+	query := AssignmentTable.SELECT(AssignmentProjections).
+		FROM(AssignmentTable).
+		WHERE(AssignmentTable.ID.IN(int32Expressions(assignmentIDs)...).AND(AssignmentTable.DeletedAt.IS_NULL())).
+		ORDER_BY(AssignmentTable.ID).
+		FOR(UPDATE())
+
+	assignments := []Assignment{}
+	err := query.QueryContext(ctx, tx, &assignments)
+
+	return assignments, err
+}
+
+func (r *AssignmentRepository) FindAssignmentOwnersByIDsWithTransaction(ctx context.Context, tx *sql.Tx, assignmentIDs []int32) ([]Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.FindAssignmentOwnersByIDsWithTransaction")
+	defer span.End()
+
+	query := AssignmentTable.SELECT(AssignmentTable.ID, AssignmentTable.UserID).
+		FROM(AssignmentTable).
+		WHERE(AssignmentTable.ID.IN(int32Expressions(assignmentIDs)...))
+
+	assignments := []Assignment{}
+	err := query.QueryContext(ctx, tx, &assignments)
+
+	return assignments, err
+}
+
+func (r *AssignmentRepository) UpdateAssignmentsIsCompletedWithTransaction(ctx context.Context, tx *sql.Tx, assignmentIDs []int32, isCompleted bool) error {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.UpdateAssignmentsIsCompletedWithTransaction")
+	defer span.End()
+
+	query := AssignmentTable.UPDATE(AssignmentTable.IsCompleted, AssignmentTable.CompletedAt, AssignmentTable.UpdatedAt, AssignmentTable.Version).
+		SET(isCompleted, updatedCompletedAt(&isCompleted), time.Now(), AssignmentTable.Version.ADD(Int(1))).
+		WHERE(AssignmentTable.ID.IN(int32Expressions(assignmentIDs)...).AND(AssignmentTable.DeletedAt.IS_NULL()))
+
+	_, err := query.ExecContext(ctx, tx)
+	return err
+}
+
+func (r *AssignmentRepository) UpdateAssignmentsIsImportantWithTransaction(ctx context.Context, tx *sql.Tx, assignmentIDs []int32, isImportant bool) error {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.UpdateAssignmentsIsImportantWithTransaction")
+	defer span.End()
+
+	query := AssignmentTable.UPDATE(AssignmentTable.IsImportant, AssignmentTable.UpdatedAt, AssignmentTable.Version).
+		SET(isImportant, time.Now(), AssignmentTable.Version.ADD(Int(1))).
+		WHERE(AssignmentTable.ID.IN(int32Expressions(assignmentIDs)...).AND(AssignmentTable.DeletedAt.IS_NULL()))
+
+	_, err := query.ExecContext(ctx, tx)
+	return err
+}
+
+func (r *AssignmentRepository) UpdateAssignmentsWorkspaceIDWithTransaction(ctx context.Context, tx *sql.Tx, assignmentIDs []int32, workspaceID *int32) error {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.UpdateAssignmentsWorkspaceIDWithTransaction")
+	defer span.End()
+
+	query := AssignmentTable.UPDATE(AssignmentTable.WorkspaceID, AssignmentTable.UpdatedAt, AssignmentTable.Version).
+		SET(workspaceID, time.Now(), AssignmentTable.Version.ADD(Int(1))).
+		WHERE(AssignmentTable.ID.IN(int32Expressions(assignmentIDs)...).AND(AssignmentTable.DeletedAt.IS_NULL()))
+
+	_, err := query.ExecContext(ctx, tx)
+	return err
+}
+
+func (r *AssignmentRepository) DeleteAssignmentsByIDsWithTransaction(ctx context.Context, tx *sql.Tx, assignmentIDs []int32) error {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.DeleteAssignmentsByIDsWithTransaction")
+	defer span.End()
+
+	query := AssignmentTable.DELETE().
+		WHERE(AssignmentTable.ID.IN(int32Expressions(assignmentIDs)...))
+
+	_, err := query.ExecContext(ctx, tx)
+	return err
+}
+
+func (r *AssignmentRepository) SoftDeleteAssignmentWithTransaction(ctx context.Context, tx *sql.Tx, assignment Assignment) (Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.SoftDeleteAssignmentWithTransaction")
+	defer span.End()
+
+	query := AssignmentTable.UPDATE(AssignmentTable.DeletedAt, AssignmentTable.UpdatedAt, AssignmentTable.Version).
+		SET(time.Now(), time.Now(), AssignmentTable.Version.ADD(Int(1))).
+		WHERE(AssignmentTable.ID.EQ(Int32(assignment.ID)).
+			AND(AssignmentTable.Version.EQ(Int32(assignment.Version))).
+			AND(AssignmentTable.DeletedAt.IS_NULL())).
+		RETURNING(AssignmentProjections)
+
+	var a Assignment
+	err := query.QueryContext(ctx, tx, &a)
+
+	return a, err
+}
+
+func (r *AssignmentRepository) SoftDeleteAssignmentsByIDsWithTransaction(ctx context.Context, tx *sql.Tx, assignmentIDs []int32) error {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.SoftDeleteAssignmentsByIDsWithTransaction")
+	defer span.End()
+
+	query := AssignmentTable.UPDATE(AssignmentTable.DeletedAt, AssignmentTable.UpdatedAt, AssignmentTable.Version).
+		SET(time.Now(), time.Now(), AssignmentTable.Version.ADD(Int(1))).
+		WHERE(AssignmentTable.ID.IN(int32Expressions(assignmentIDs)...).AND(AssignmentTable.DeletedAt.IS_NULL()))
+
+	_, err := query.ExecContext(ctx, tx)
+	return err
+}
+
+func (r *AssignmentRepository) FindTrashedAssignmentsByUserIDJoinReminders(ctx context.Context, userID uuid.UUID) ([]Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.FindTrashedAssignmentsByUserIDJoinReminders")
+	defer span.End()
+
+	query := AssignmentTable.SELECT(AssignmentProjections, ReminderTable.AllColumns).
+		FROM(AssignmentTable.LEFT_JOIN(ReminderTable, AssignmentTable.ID.EQ(ReminderTable.AssignmentID))).
+		WHERE(AssignmentTable.UserID.EQ(UUID(userID)).AND(AssignmentTable.DeletedAt.IS_NOT_NULL())).
+		ORDER_BY(AssignmentTable.DeletedAt.DESC())
+
+	assignments := []Assignment{}
+	err := query.QueryContext(ctx, r.db.Pool, &assignments)
+
+	return assignments, err
+}
+
+func (r *AssignmentRepository) FindTrashedAssignmentByID(ctx context.Context, assignmentID int32) (Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.FindTrashedAssignmentByID")
+	defer span.End()
+
+	query := AssignmentTable.SELECT(AssignmentProjections).
+		FROM(AssignmentTable).
+		WHERE(AssignmentTable.ID.EQ(Int32(assignmentID)).AND(AssignmentTable.DeletedAt.IS_NOT_NULL()))
+
+	var a Assignment
+	err := query.QueryContext(ctx, r.db.Pool, &a)
+
+	return a, err
+}
+
+func (r *AssignmentRepository) RestoreAssignmentByIDWithTransaction(ctx context.Context, tx *sql.Tx, assignmentID int32) (Assignment, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.RestoreAssignmentByIDWithTransaction")
+	defer span.End()
+
+	query := AssignmentTable.UPDATE(AssignmentTable.DeletedAt, AssignmentTable.UpdatedAt, AssignmentTable.Version).
+		SET(NULL, time.Now(), AssignmentTable.Version.ADD(Int(1))).
+		WHERE(AssignmentTable.ID.EQ(Int32(assignmentID)).AND(AssignmentTable.DeletedAt.IS_NOT_NULL())).
+		RETURNING(AssignmentProjections)
 
 	var a Assignment
 	err := query.QueryContext(ctx, tx, &a)
 
 	return a, err
 }
+
+func (r *AssignmentRepository) FindAssignmentIDsTrashedBefore(ctx context.Context, before time.Time, limit int64) ([]int32, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.FindAssignmentIDsTrashedBefore")
+	defer span.End()
+
+	query := AssignmentTable.SELECT(AssignmentTable.ID).
+		FROM(AssignmentTable).
+		WHERE(AssignmentTable.DeletedAt.LT(TimestampzT(before))).
+		ORDER_BY(AssignmentTable.DeletedAt.ASC()).
+		LIMIT(limit)
+
+	var dest []struct {
+		ID int32 `alias:"assignment.id"`
+	}
+	if err := query.QueryContext(ctx, r.db.Pool, &dest); err != nil {
+		return nil, err
+	}
+
+	ids := make([]int32, len(dest))
+	for i, d := range dest {
+		ids[i] = d.ID
+	}
+
+	return ids, nil
+}
+
+func (r *AssignmentRepository) SearchAssignments(ctx context.Context, userID uuid.UUID, tsQuery string, limit int64) ([]AssignmentSearchResult, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentRepository.SearchAssignments")
+	defer span.End()
+
+	args := RawArgs{"#query": tsQuery}
+	rank := FloatExp(Raw("ts_rank(assignment.search_vector, to_tsquery(assignment.search_config, #query))", args))
+
+	query := AssignmentTable.SELECT(
+		AssignmentProjections,
+		rank.AS("assignment_search_result.rank"),
+		Raw("ts_headline(assignment.search_config, assignment.title, to_tsquery(assignment.search_config, #query), 'HighlightAll=true')", args).
+			AS("assignment_search_result.title_highlight"),
+		Raw("ts_headline(assignment.search_config, assignment.note, to_tsquery(assignment.search_config, #query), 'MaxFragments=2, MaxWords=20, MinWords=5')", args).
+			AS("assignment_search_result.note_highlight"),
+	).
+		FROM(AssignmentTable).
+		WHERE(
+			BoolExp(Raw("assignment.search_vector @@ to_tsquery(assignment.search_config, #query)", args)).
+				AND(assignmentVisibleTo(userID)).
+				AND(AssignmentTable.DeletedAt.IS_NULL()),
+		).
+		ORDER_BY(rank.DESC(), AssignmentTable.UpdatedAt.DESC()).
+		LIMIT(limit)
+
+	results := []AssignmentSearchResult{}
+	err := query.QueryContext(ctx, r.db.Pool, &results)
+
+	return results, err
+}
+
+func assignmentVisibleTo(userID uuid.UUID) BoolExpression {
+	sharedAssignmentIDs := CollaboratorTable.SELECT(CollaboratorTable.AssignmentID).
+		FROM(CollaboratorTable).
+		WHERE(CollaboratorTable.UserID.EQ(UUID(userID)).AND(CollaboratorTable.AcceptedAt.IS_NOT_NULL()))
+
+	return AssignmentTable.UserID.EQ(UUID(userID)).OR(AssignmentTable.ID.IN(sharedAssignmentIDs))
+}
+
+func int32Expressions(values []int32) []Expression {
+	expressions := make([]Expression, len(values))
+	for i, v := range values {
+		expressions[i] = Int32(v)
+	}
+	return expressions
+}
+
+func uuidExpressions(values []uuid.UUID) []Expression {
+	expressions := make([]Expression, len(values))
+	for i, v := range values {
+		expressions[i] = UUID(v)
+	}
+	return expressions
+}
+
+func insertedCompletedAt(isCompleted *bool) Expression {
+	if isCompleted == nil || !*isCompleted {
+		return NULL
+	}
+
+	return NOW()
+}
+
+func insertedSearchConfig(searchConfig string) Expression {
+	if searchConfig == "" {
+		searchConfig = searchDefaultConfig
+	}
+
+	return CAST(String(searchConfig)).AS("regconfig")
+}
+
+func updatedCompletedAt(isCompleted *bool) Expression {
+	if isCompleted == nil || !*isCompleted {
+		return NULL
+	}
+
+	return COALESCE(AssignmentTable.CompletedAt, NOW())
+}
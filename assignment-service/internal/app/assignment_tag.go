@@ -0,0 +1,29 @@
+package app
+
+import (
+	"slices"
+	"strings"
+)
+
+type AssignmentTag struct {
+	AssignmentID int32  `json:"assignment_id" sql:"primary_key"`
+	Tag          string `json:"tag" sql:"primary_key"`
+}
+
+type UpdateAssignmentTagsRequest struct {
+	Tags []string `json:"tags" validate:"max=20,dive,required,max=64"`
+}
+
+func normalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || slices.Contains(normalized, tag) {
+			continue
+		}
+		normalized = append(normalized, tag)
+	}
+	slices.Sort(normalized)
+
+	return normalized
+}
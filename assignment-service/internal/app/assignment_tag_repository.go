@@ -0,0 +1,71 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var AssignmentTagTable = table.AssignmentTags.AS("assignment_tag")
+
+type AssignmentTagRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewAssignmentTagRepository(db *database.Service, tracer trace.Tracer) *AssignmentTagRepository {
+	return &AssignmentTagRepository{db, tracer}
+}
+
+func (r *AssignmentTagRepository) FindTagsByAssignmentID(ctx context.Context, assignmentID int32) ([]string, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentTagRepository.FindTagsByAssignmentID")
+	defer span.End()
+
+	query := AssignmentTagTable.SELECT(AssignmentTagTable.AllColumns).
+		FROM(AssignmentTagTable).
+		WHERE(AssignmentTagTable.AssignmentID.EQ(Int32(assignmentID))).
+		ORDER_BY(AssignmentTagTable.Tag.ASC())
+
+	var assignmentTags []AssignmentTag
+	if err := query.QueryContext(ctx, r.db.Pool, &assignmentTags); err != nil {
+		return nil, err
+	}
+
+	tags := make([]string, 0, len(assignmentTags))
+	for _, assignmentTag := range assignmentTags {
+		tags = append(tags, assignmentTag.Tag)
+	}
+
+	return tags, nil
+}
+
+func (r *AssignmentTagRepository) ReplaceAssignmentTagsWithTransaction(ctx context.Context, tx *sql.Tx, assignmentID int32, tags []string) error {
+	ctx, span := r.tracer.Start(ctx, "AssignmentTagRepository.ReplaceAssignmentTagsWithTransaction")
+	defer span.End()
+
+	deleteQuery := AssignmentTagTable.DELETE().
+		WHERE(AssignmentTagTable.AssignmentID.EQ(Int32(assignmentID)))
+
+	if _, err := deleteQuery.ExecContext(ctx, tx); err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	insertQuery := AssignmentTagTable.INSERT(AssignmentTagTable.AssignmentID, AssignmentTagTable.Tag)
+	for _, tag := range tags {
+		insertQuery = insertQuery.VALUES(assignmentID, tag)
+	}
+
+	_, err := insertQuery.ExecContext(ctx, tx)
+
+	return err
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func (s *Service) GetAssignmentTags(ctx context.Context, userID uuid.UUID, assignmentID int32) ([]string, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetAssignmentTags")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleViewer)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	tags, err := s.assignmentTagRepository.FindTagsByAssignmentID(ctx, assignment.ID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return tags, nil
+}
+
+func (s *Service) UpdateAssignmentTags(ctx context.Context, userID uuid.UUID, assignmentID int32, request UpdateAssignmentTagsRequest) ([]string, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.UpdateAssignmentTags")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleEditor)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	tags := normalizeTags(request.Tags)
+
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	if err := s.assignmentTagRepository.ReplaceAssignmentTagsWithTransaction(ctx, tx, assignment.ID, tags); err != nil {
+		tx.Rollback()
+		return nil, NewInternalServiceError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type AssignmentTemplate struct {
+	ID              int32                   `json:"id" sql:"primary_key"`
+	UserID          uuid.UUID               `json:"user_id"`
+	WorkspaceID     *int32                  `json:"workspace_id"`
+	Name            string                  `json:"name"`
+	TitlePattern    string                  `json:"title_pattern"`
+	Note            *string                 `json:"note"`
+	IsImportant     bool                    `json:"is_important"`
+	ReminderOffsets TemplateReminderOffsets `json:"reminder_offsets"`
+	Tags            TemplateTags            `json:"tags"`
+	UseCount        int32                   `json:"use_count"`
+	CreatedAt       *time.Time              `json:"created_at"`
+	UpdatedAt       *time.Time              `json:"updated_at"`
+}
+
+func (t AssignmentTemplate) title(n int32, dueDate time.Time) string {
+	_, week := dueDate.ISOWeek()
+
+	return strings.NewReplacer(
+		"{n}", strconv.Itoa(int(n)),
+		"{date}", dueDate.Format(time.DateOnly),
+		"{week}", strconv.Itoa(week),
+		"{weekday}", dueDate.Weekday().String(),
+	).Replace(t.TitlePattern)
+}
+
+func (t AssignmentTemplate) toCreateAssignmentRequest(title string, dueDate time.Time) CreateAssignmentRequest {
+	note := ""
+	if t.Note != nil {
+		note = *t.Note
+	}
+	isImportant := t.IsImportant
+
+	reminders := make([]CreateReminderRequest, len(t.ReminderOffsets))
+	for i, offset := range t.ReminderOffsets {
+		reminders[i] = CreateReminderRequest{BeforeDueMinutes: &offset}
+	}
+
+	return CreateAssignmentRequest{
+		Title:       title,
+		Note:        &note,
+		DueDate:     &dueDate,
+		IsImportant: &isImportant,
+		Reminders:   reminders,
+	}
+}
+
+type TemplateReminderOffsets []int32
+
+func (o *TemplateReminderOffsets) Scan(value any) error {
+	return scanJSONColumn(value, o)
+}
+
+type TemplateTags []string
+
+func (t *TemplateTags) Scan(value any) error {
+	return scanJSONColumn(value, t)
+}
+
+func scanJSONColumn(value any, dest any) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, dest)
+	case string:
+		return json.Unmarshal([]byte(v), dest)
+	case nil:
+		return nil
+	default:
+		return errors.Errorf("cannot scan %T into %T", value, dest)
+	}
+}
+
+type AssignmentTemplateRequest struct {
+	Name            string   `json:"name" validate:"required,max=255"`
+	TitlePattern    string   `json:"title_pattern" validate:"required,max=255"`
+	Note            *string  `json:"note"`
+	IsImportant     bool     `json:"is_important"`
+	ReminderOffsets []int32  `json:"reminder_offsets" validate:"max=10,dive,min=0,max=525600"`
+	Tags            []string `json:"tags" validate:"max=20,dive,required,max=64"`
+	WorkspaceID     *int32   `json:"workspace_id" validate:"omitempty,min=1"`
+}
+
+func (r AssignmentTemplateRequest) toAssignmentTemplate(userID uuid.UUID) AssignmentTemplate {
+	offsets := TemplateReminderOffsets(r.ReminderOffsets)
+	if offsets == nil {
+		offsets = TemplateReminderOffsets{}
+	}
+
+	return AssignmentTemplate{
+		UserID:          userID,
+		WorkspaceID:     r.WorkspaceID,
+		Name:            r.Name,
+		TitlePattern:    r.TitlePattern,
+		Note:            r.Note,
+		IsImportant:     r.IsImportant,
+		ReminderOffsets: offsets,
+		Tags:            normalizeTags(r.Tags),
+	}
+}
+
+type CreateAssignmentFromTemplateRequest struct {
+	DueDate *time.Time `json:"due_date" validate:"required,future"`
+	Title   string     `json:"title" validate:"max=255"`
+}
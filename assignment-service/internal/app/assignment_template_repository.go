@@ -0,0 +1,174 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var AssignmentTemplateTable = table.AssignmentTemplates.AS("assignment_template")
+
+type AssignmentTemplateRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewAssignmentTemplateRepository(db *database.Service, tracer trace.Tracer) *AssignmentTemplateRepository {
+	return &AssignmentTemplateRepository{db, tracer}
+}
+
+func (r *AssignmentTemplateRepository) InsertAssignmentTemplate(ctx context.Context, template AssignmentTemplate) (AssignmentTemplate, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentTemplateRepository.InsertAssignmentTemplate")
+	defer span.End()
+
+	offsets, err := json.Marshal(template.ReminderOffsets)
+	if err != nil {
+		return AssignmentTemplate{}, err
+	}
+
+	tags, err := json.Marshal(template.Tags)
+	if err != nil {
+		return AssignmentTemplate{}, err
+	}
+
+	query := AssignmentTemplateTable.INSERT(
+		AssignmentTemplateTable.UserID,
+		AssignmentTemplateTable.WorkspaceID,
+		AssignmentTemplateTable.Name,
+		AssignmentTemplateTable.TitlePattern,
+		AssignmentTemplateTable.Note,
+		AssignmentTemplateTable.IsImportant,
+		AssignmentTemplateTable.ReminderOffsets,
+		AssignmentTemplateTable.Tags,
+	).
+		VALUES(
+			template.UserID,
+			template.WorkspaceID,
+			template.Name,
+			template.TitlePattern,
+			template.Note,
+			template.IsImportant,
+			Json(offsets),
+			Json(tags),
+		).
+		RETURNING(AssignmentTemplateTable.AllColumns)
+
+	var inserted AssignmentTemplate
+	err = query.QueryContext(ctx, r.db.Pool, &inserted)
+
+	return inserted, err
+}
+
+func (r *AssignmentTemplateRepository) FindAssignmentTemplateByID(ctx context.Context, id int32) (AssignmentTemplate, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentTemplateRepository.FindAssignmentTemplateByID")
+	defer span.End()
+
+	query := AssignmentTemplateTable.SELECT(AssignmentTemplateTable.AllColumns).
+		FROM(AssignmentTemplateTable).
+		WHERE(AssignmentTemplateTable.ID.EQ(Int32(id)))
+
+	var template AssignmentTemplate
+	err := query.QueryContext(ctx, r.db.Pool, &template)
+
+	return template, err
+}
+
+func (r *AssignmentTemplateRepository) FindAssignmentTemplatesByUserID(ctx context.Context, userID uuid.UUID) ([]AssignmentTemplate, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentTemplateRepository.FindAssignmentTemplatesByUserID")
+	defer span.End()
+
+	memberWorkspaceIDs := WorkspaceMemberTable.SELECT(WorkspaceMemberTable.WorkspaceID).
+		FROM(WorkspaceMemberTable).
+		WHERE(WorkspaceMemberTable.UserID.EQ(UUID(userID)))
+
+	query := AssignmentTemplateTable.SELECT(AssignmentTemplateTable.AllColumns).
+		FROM(AssignmentTemplateTable).
+		WHERE(
+			AssignmentTemplateTable.UserID.EQ(UUID(userID)).
+				OR(AssignmentTemplateTable.WorkspaceID.IN(memberWorkspaceIDs)),
+		).
+		ORDER_BY(AssignmentTemplateTable.Name.ASC(), AssignmentTemplateTable.ID.ASC())
+
+	templates := []AssignmentTemplate{}
+	err := query.QueryContext(ctx, r.db.Pool, &templates)
+
+	return templates, err
+}
+
+func (r *AssignmentTemplateRepository) UpdateAssignmentTemplate(ctx context.Context, template AssignmentTemplate) (AssignmentTemplate, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentTemplateRepository.UpdateAssignmentTemplate")
+	defer span.End()
+
+	offsets, err := json.Marshal(template.ReminderOffsets)
+	if err != nil {
+		return AssignmentTemplate{}, err
+	}
+
+	tags, err := json.Marshal(template.Tags)
+	if err != nil {
+		return AssignmentTemplate{}, err
+	}
+
+	query := AssignmentTemplateTable.UPDATE(
+		AssignmentTemplateTable.WorkspaceID,
+		AssignmentTemplateTable.Name,
+		AssignmentTemplateTable.TitlePattern,
+		AssignmentTemplateTable.Note,
+		AssignmentTemplateTable.IsImportant,
+		AssignmentTemplateTable.ReminderOffsets,
+		AssignmentTemplateTable.Tags,
+		AssignmentTemplateTable.UpdatedAt,
+	).
+		SET(
+			template.WorkspaceID,
+			template.Name,
+			template.TitlePattern,
+			template.Note,
+			template.IsImportant,
+			Json(offsets),
+			Json(tags),
+			time.Now(),
+		).
+		WHERE(AssignmentTemplateTable.ID.EQ(Int32(template.ID))).
+		RETURNING(AssignmentTemplateTable.AllColumns)
+
+	var updated AssignmentTemplate
+	err = query.QueryContext(ctx, r.db.Pool, &updated)
+
+	return updated, err
+}
+
+func (r *AssignmentTemplateRepository) IncrementAssignmentTemplateUseCountWithTransaction(ctx context.Context, tx *sql.Tx, id int32) (AssignmentTemplate, error) {
+	ctx, span := r.tracer.Start(ctx, "AssignmentTemplateRepository.IncrementAssignmentTemplateUseCountWithTransaction")
+	defer span.End()
+
+	query := AssignmentTemplateTable.UPDATE(AssignmentTemplateTable.UseCount).
+		SET(AssignmentTemplateTable.UseCount.ADD(Int32(1))).
+		WHERE(AssignmentTemplateTable.ID.EQ(Int32(id))).
+		RETURNING(AssignmentTemplateTable.AllColumns)
+
+	var updated AssignmentTemplate
+	err := query.QueryContext(ctx, tx, &updated)
+
+	return updated, err
+}
+
+func (r *AssignmentTemplateRepository) DeleteAssignmentTemplateByID(ctx context.Context, id int32) error {
+	ctx, span := r.tracer.Start(ctx, "AssignmentTemplateRepository.DeleteAssignmentTemplateByID")
+	defer span.End()
+
+	query := AssignmentTemplateTable.DELETE().WHERE(AssignmentTemplateTable.ID.EQ(Int32(id)))
+
+	_, err := query.ExecContext(ctx, r.db.Pool)
+
+	return err
+}
@@ -0,0 +1,166 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) getAuthorizedAssignmentTemplateByID(ctx context.Context, userID uuid.UUID, templateID int32, required WorkspaceRole) (AssignmentTemplate, ServiceError) {
+	template, err := s.assignmentTemplateRepository.FindAssignmentTemplateByID(ctx, templateID)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return AssignmentTemplate{}, NewClientError(http.StatusNotFound, errors.New("assignment template not found"))
+	}
+	if err != nil {
+		return AssignmentTemplate{}, NewInternalServiceError(err)
+	}
+
+	if template.UserID == userID {
+		return template, nil
+	}
+	if template.WorkspaceID == nil {
+		return AssignmentTemplate{}, NewClientError(http.StatusNotFound, errors.New("assignment template not found"))
+	}
+
+	if _, serviceErr := s.getAuthorizedWorkspaceByID(ctx, userID, *template.WorkspaceID, required); serviceErr != nil {
+		return AssignmentTemplate{}, serviceErr
+	}
+
+	return template, nil
+}
+
+func (s *Service) CreateAssignmentTemplate(ctx context.Context, userID uuid.UUID, request AssignmentTemplateRequest) (AssignmentTemplate, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.CreateAssignmentTemplate")
+	defer span.End()
+
+	if request.WorkspaceID != nil {
+		if _, serviceErr := s.getAuthorizedWorkspaceByID(ctx, userID, *request.WorkspaceID, WorkspaceRoleMember); serviceErr != nil {
+			return AssignmentTemplate{}, serviceErr
+		}
+	}
+
+	template, err := s.assignmentTemplateRepository.InsertAssignmentTemplate(ctx, request.toAssignmentTemplate(userID))
+	if err != nil {
+		return AssignmentTemplate{}, NewInternalServiceError(err)
+	}
+
+	return template, nil
+}
+
+func (s *Service) GetAssignmentTemplates(ctx context.Context, userID uuid.UUID) ([]AssignmentTemplate, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetAssignmentTemplates")
+	defer span.End()
+
+	templates, err := s.assignmentTemplateRepository.FindAssignmentTemplatesByUserID(ctx, userID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return templates, nil
+}
+
+func (s *Service) GetAssignmentTemplateByID(ctx context.Context, userID uuid.UUID, templateID int32) (AssignmentTemplate, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetAssignmentTemplateByID")
+	defer span.End()
+
+	return s.getAuthorizedAssignmentTemplateByID(ctx, userID, templateID, WorkspaceRoleMember)
+}
+
+func (s *Service) UpdateAssignmentTemplateByID(ctx context.Context, userID uuid.UUID, templateID int32, request AssignmentTemplateRequest) (AssignmentTemplate, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.UpdateAssignmentTemplateByID")
+	defer span.End()
+
+	template, serviceErr := s.getAuthorizedAssignmentTemplateByID(ctx, userID, templateID, WorkspaceRoleOwner)
+	if serviceErr != nil {
+		return AssignmentTemplate{}, serviceErr
+	}
+
+	if request.WorkspaceID != nil {
+		if _, serviceErr := s.getAuthorizedWorkspaceByID(ctx, userID, *request.WorkspaceID, WorkspaceRoleMember); serviceErr != nil {
+			return AssignmentTemplate{}, serviceErr
+		}
+	}
+
+	updated := request.toAssignmentTemplate(template.UserID)
+	updated.ID = template.ID
+
+	updated, err := s.assignmentTemplateRepository.UpdateAssignmentTemplate(ctx, updated)
+	if err != nil {
+		return AssignmentTemplate{}, NewInternalServiceError(err)
+	}
+
+	return updated, nil
+}
+
+func (s *Service) DeleteAssignmentTemplateByID(ctx context.Context, userID uuid.UUID, templateID int32) ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.DeleteAssignmentTemplateByID")
+	defer span.End()
+
+	template, serviceErr := s.getAuthorizedAssignmentTemplateByID(ctx, userID, templateID, WorkspaceRoleOwner)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	if err := s.assignmentTemplateRepository.DeleteAssignmentTemplateByID(ctx, template.ID); err != nil {
+		return NewInternalServiceError(err)
+	}
+
+	return nil
+}
+
+func (s *Service) CreateAssignmentFromTemplate(ctx context.Context, userID uuid.UUID, templateID int32, request CreateAssignmentFromTemplateRequest) (Assignment, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.CreateAssignmentFromTemplate")
+	defer span.End()
+
+	template, serviceErr := s.getAuthorizedAssignmentTemplateByID(ctx, userID, templateID, WorkspaceRoleMember)
+	if serviceErr != nil {
+		return Assignment{}, serviceErr
+	}
+
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	template, err = s.assignmentTemplateRepository.IncrementAssignmentTemplateUseCountWithTransaction(ctx, tx, template.ID)
+	if err != nil {
+		tx.Rollback()
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	title := request.Title
+	if title == "" {
+		title = template.title(template.UseCount, request.DueDate.In(s.userLocation(ctx, userID)))
+	}
+
+	createRequest := template.toCreateAssignmentRequest(title, *request.DueDate)
+	assignment, reminders := createRequest.toAssignmentAndReminders(userID)
+
+	reminders, serviceErr = s.scheduleReminders(ctx, userID, assignment.DueDate, reminders)
+	if serviceErr != nil {
+		tx.Rollback()
+		return Assignment{}, serviceErr
+	}
+
+	assignment, err = s.insertAssignmentWithTransaction(ctx, tx, userID, assignment, reminders, template.Tags)
+	if err != nil {
+		tx.Rollback()
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	err = s.triggerMissionEvent(ctx, userID, newAssignmentMissionEvent(missionservice.TriggerMissionEvent_MISSION_EVENT_CREATE_ASSIGNMENT, assignment, time.Now()))
+	if err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	return assignment, nil
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Attachment struct {
+	ID           int32      `json:"id" sql:"primary_key"`
+	AssignmentID int32      `json:"assignment_id"`
+	UserID       uuid.UUID  `json:"user_id"`
+	FileName     string     `json:"file_name"`
+	ContentType  string     `json:"content_type"`
+	Size         int64      `json:"size"`
+	ObjectKey    string     `json:"-"`
+	URL          string     `json:"url"`
+	CreatedAt    *time.Time `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+}
+
+type UploadAttachmentRequest struct {
+	FileName string
+	File     []byte
+}
+
+type AttachmentConfig struct {
+	Bucket              string
+	UserQuota           int64
+	AllowedContentTypes []string
+	SigningKey          []byte
+}
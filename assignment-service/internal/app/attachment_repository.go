@@ -0,0 +1,128 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var AttachmentTable = table.Attachments.AS("attachment")
+
+type AttachmentRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewAttachmentRepository(db *database.Service, tracer trace.Tracer) *AttachmentRepository {
+	return &AttachmentRepository{db, tracer}
+}
+
+func (r *AttachmentRepository) LockAttachmentsByUserIDWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID) error {
+	ctx, span := r.tracer.Start(ctx, "AttachmentRepository.LockAttachmentsByUserIDWithTransaction")
+	defer span.End()
+
+	query := RawStatement("SELECT pg_advisory_xact_lock(hashtext(#key))", RawArgs{"#key": "attachments/" + userID.String()})
+
+	_, err := query.ExecContext(ctx, tx)
+
+	return err
+}
+
+func (r *AttachmentRepository) InsertAttachmentWithTransaction(ctx context.Context, tx *sql.Tx, attachment Attachment) (Attachment, error) {
+	ctx, span := r.tracer.Start(ctx, "AttachmentRepository.InsertAttachmentWithTransaction")
+	defer span.End()
+
+	query := AttachmentTable.INSERT(
+		AttachmentTable.AssignmentID,
+		AttachmentTable.UserID,
+		AttachmentTable.FileName,
+		AttachmentTable.ContentType,
+		AttachmentTable.Size,
+		AttachmentTable.ObjectKey,
+	).
+		VALUES(attachment.AssignmentID, attachment.UserID, attachment.FileName, attachment.ContentType, attachment.Size, attachment.ObjectKey).
+		RETURNING(AttachmentTable.AllColumns)
+
+	var a Attachment
+	err := query.QueryContext(ctx, tx, &a)
+
+	return a, err
+}
+
+func (r *AttachmentRepository) FindAttachmentsByAssignmentID(ctx context.Context, assignmentID int32) ([]Attachment, error) {
+	ctx, span := r.tracer.Start(ctx, "AttachmentRepository.FindAttachmentsByAssignmentID")
+	defer span.End()
+
+	query := AttachmentTable.SELECT(AttachmentTable.AllColumns).
+		FROM(AttachmentTable).
+		WHERE(AttachmentTable.AssignmentID.EQ(Int32(assignmentID))).
+		ORDER_BY(AttachmentTable.CreatedAt.ASC())
+
+	attachments := []Attachment{}
+	err := query.QueryContext(ctx, r.db.Pool, &attachments)
+
+	return attachments, err
+}
+
+func (r *AttachmentRepository) FindAttachmentByID(ctx context.Context, attachmentID int32) (Attachment, error) {
+	ctx, span := r.tracer.Start(ctx, "AttachmentRepository.FindAttachmentByID")
+	defer span.End()
+
+	query := AttachmentTable.SELECT(AttachmentTable.AllColumns).
+		FROM(AttachmentTable).
+		WHERE(AttachmentTable.ID.EQ(Int32(attachmentID)))
+
+	var a Attachment
+	err := query.QueryContext(ctx, r.db.Pool, &a)
+
+	return a, err
+}
+
+func (r *AttachmentRepository) SumAttachmentSizeByUserIDWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "AttachmentRepository.SumAttachmentSizeByUserIDWithTransaction")
+	defer span.End()
+
+	query := SELECT(COALESCE(SUM(AttachmentTable.Size), Int(0)).AS("total")).
+		FROM(AttachmentTable).
+		WHERE(AttachmentTable.UserID.EQ(UUID(userID)))
+
+	var dest struct {
+		Total int64
+	}
+	err := query.QueryContext(ctx, tx, &dest)
+
+	return dest.Total, err
+}
+
+func (r *AttachmentRepository) DeleteAttachmentByID(ctx context.Context, attachmentID int32) error {
+	ctx, span := r.tracer.Start(ctx, "AttachmentRepository.DeleteAttachmentByID")
+	defer span.End()
+
+	query := AttachmentTable.DELETE().
+		WHERE(AttachmentTable.ID.EQ(Int32(attachmentID)))
+
+	_, err := query.ExecContext(ctx, r.db.Pool)
+
+	return err
+}
+
+func (r *AttachmentRepository) DeleteAttachmentsByAssignmentIDsWithTransaction(ctx context.Context, tx *sql.Tx, assignmentIDs []int32) ([]Attachment, error) {
+	ctx, span := r.tracer.Start(ctx, "AttachmentRepository.DeleteAttachmentsByAssignmentIDsWithTransaction")
+	defer span.End()
+
+	query := AttachmentTable.DELETE().
+		WHERE(AttachmentTable.AssignmentID.IN(int32Expressions(assignmentIDs)...)).
+		RETURNING(AttachmentTable.AllColumns)
+
+	var deleted []Attachment
+	err := query.QueryContext(ctx, tx, &deleted)
+
+	return deleted, err
+}
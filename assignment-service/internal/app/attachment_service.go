@@ -0,0 +1,189 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	attachmentTokenAudience   = "attachments"
+	attachmentURLExpiration   = time.Minute * 15
+	attachmentStaticURLPrefix = "attachments/"
+)
+
+func (s *Service) UploadAttachment(ctx context.Context, userID uuid.UUID, assignmentID int32, request UploadAttachmentRequest) (Attachment, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.UploadAttachment")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleEditor)
+	if serviceErr != nil {
+		return Attachment{}, serviceErr
+	}
+
+	_, detectingContentTypeSpan := s.tracer.Start(ctx, "detecting content type")
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(request.File))
+	detectingContentTypeSpan.End()
+	if err != nil || !slices.Contains(s.attachmentConfig.AllowedContentTypes, contentType) {
+		return Attachment{}, NewClientError(http.StatusUnsupportedMediaType, fmt.Errorf("content type %q is not allowed", contentType))
+	}
+
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return Attachment{}, NewInternalServiceError(err)
+	}
+
+	if err := s.attachmentRepository.LockAttachmentsByUserIDWithTransaction(ctx, tx, userID); err != nil {
+		tx.Rollback()
+		return Attachment{}, NewInternalServiceError(err)
+	}
+
+	used, err := s.attachmentRepository.SumAttachmentSizeByUserIDWithTransaction(ctx, tx, userID)
+	if err != nil {
+		tx.Rollback()
+		return Attachment{}, NewInternalServiceError(err)
+	}
+
+	size := int64(len(request.File))
+	if used+size > s.attachmentConfig.UserQuota {
+		tx.Rollback()
+		return Attachment{}, NewClientError(http.StatusRequestEntityTooLarge, errors.New("attachment quota exceeded"))
+	}
+
+	key := fmt.Sprintf("%s/%d/%s", userID, assignment.ID, uuid.New())
+
+	attachment, err := s.attachmentRepository.InsertAttachmentWithTransaction(ctx, tx, Attachment{
+		AssignmentID: assignment.ID,
+		UserID:       userID,
+		FileName:     request.FileName,
+		ContentType:  contentType,
+		Size:         size,
+		ObjectKey:    key,
+	})
+	if err != nil {
+		tx.Rollback()
+		return Attachment{}, NewInternalServiceError(err)
+	}
+
+	contentDisposition := mime.FormatMediaType("attachment", map[string]string{"filename": request.FileName})
+
+	_, putFileToBucketSpan := s.tracer.Start(ctx, "putting file to bucket")
+	_, err = s.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:             &s.attachmentConfig.Bucket,
+		Key:                &key,
+		Body:               bytes.NewReader(request.File),
+		ContentType:        &contentType,
+		ContentDisposition: &contentDisposition,
+	})
+	putFileToBucketSpan.End()
+	if err != nil {
+		tx.Rollback()
+		return Attachment{}, NewInternalServiceError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.deleteAttachmentObjects(ctx, []Attachment{attachment})
+		return Attachment{}, NewInternalServiceError(err)
+	}
+
+	if err := s.signAttachmentURL(&attachment); err != nil {
+		return Attachment{}, NewInternalServiceError(err)
+	}
+
+	return attachment, nil
+}
+
+func (s *Service) GetAttachments(ctx context.Context, userID uuid.UUID, assignmentID int32) ([]Attachment, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetAttachments")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleViewer)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	attachments, err := s.attachmentRepository.FindAttachmentsByAssignmentID(ctx, assignment.ID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	for i := range attachments {
+		if err := s.signAttachmentURL(&attachments[i]); err != nil {
+			return nil, NewInternalServiceError(err)
+		}
+	}
+
+	return attachments, nil
+}
+
+func (s *Service) DeleteAttachmentByID(ctx context.Context, userID uuid.UUID, assignmentID int32, attachmentID int32) ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.DeleteAttachmentByID")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleEditor)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	attachment, err := s.attachmentRepository.FindAttachmentByID(ctx, attachmentID)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return NewClientError(http.StatusNotFound, err)
+	}
+	if err != nil {
+		return NewInternalServiceError(err)
+	}
+	if attachment.AssignmentID != assignment.ID {
+		return NewClientError(http.StatusNotFound, errors.New("attachment does not belong to assignment"))
+	}
+
+	if err := s.attachmentRepository.DeleteAttachmentByID(ctx, attachment.ID); err != nil {
+		return NewInternalServiceError(err)
+	}
+
+	s.deleteAttachmentObjects(ctx, []Attachment{attachment})
+
+	return nil
+}
+
+func (s *Service) signAttachmentURL(attachment *Attachment) error {
+	claims := &jwt.RegisteredClaims{
+		Subject:   attachment.ObjectKey,
+		Audience:  jwt.ClaimStrings{attachmentTokenAudience},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(attachmentURLExpiration)),
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.attachmentConfig.SigningKey)
+	if err != nil {
+		return err
+	}
+
+	attachment.URL = s.staticServiceEnpoint + attachmentStaticURLPrefix + attachment.ObjectKey + "?token=" + url.QueryEscape(token)
+
+	return nil
+}
+
+func (s *Service) deleteAttachmentObjects(ctx context.Context, attachments []Attachment) {
+	ctx, span := s.tracer.Start(ctx, "Service.deleteAttachmentObjects")
+	defer span.End()
+
+	for _, attachment := range attachments {
+		_, err := s.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: &s.attachmentConfig.Bucket,
+			Key:    &attachment.ObjectKey,
+		})
+		if err != nil {
+			span.RecordError(err)
+		}
+	}
+}
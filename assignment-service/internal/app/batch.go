@@ -0,0 +1,51 @@
+package app
+
+type BatchAction string
+
+const (
+	BatchActionComplete     BatchAction = "complete"
+	BatchActionUncomplete   BatchAction = "uncomplete"
+	BatchActionDelete       BatchAction = "delete"
+	BatchActionSetImportant BatchAction = "set_important"
+	BatchActionMoveToList   BatchAction = "move_to_list"
+)
+
+type BatchItemStatus string
+
+const (
+	BatchItemStatusOK        BatchItemStatus = "ok"
+	BatchItemStatusUnchanged BatchItemStatus = "unchanged"
+	BatchItemStatusNotFound  BatchItemStatus = "not_found"
+	BatchItemStatusForbidden BatchItemStatus = "forbidden"
+	BatchItemStatusBlocked   BatchItemStatus = "blocked"
+)
+
+type BatchAssignmentRequest struct {
+	Action      BatchAction `json:"action" validate:"required,oneof=complete uncomplete delete set_important move_to_list"`
+	IDs         []int32     `json:"ids" validate:"required,min=1,max=100,unique"`
+	IsImportant *bool       `json:"is_important" validate:"required_if=Action set_important"`
+	WorkspaceID *int32      `json:"workspace_id" validate:"required_if=Action move_to_list,omitempty,min=0"`
+	Force       bool        `json:"force"`
+}
+
+// targetWorkspaceID returns the list a move_to_list batch files assignments
+// under, where zero moves them back to the user's personal list.
+func (r BatchAssignmentRequest) targetWorkspaceID() *int32 {
+	if r.WorkspaceID == nil || *r.WorkspaceID == 0 {
+		return nil
+	}
+
+	return r.WorkspaceID
+}
+
+type BatchItemResult struct {
+	ID     int32           `json:"id"`
+	Status BatchItemStatus `json:"status"`
+}
+
+type BatchAssignmentResult struct {
+	Action    BatchAction       `json:"action"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Results   []BatchItemResult `json:"results"`
+}
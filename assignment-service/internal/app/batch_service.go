@@ -0,0 +1,215 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+	"github.com/google/uuid"
+)
+
+func (s *Service) BatchAssignments(ctx context.Context, userID uuid.UUID, request BatchAssignmentRequest) (BatchAssignmentResult, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.BatchAssignments")
+	defer span.End()
+
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return BatchAssignmentResult{}, NewInternalServiceError(err)
+	}
+
+	if workspaceID := request.targetWorkspaceID(); request.Action == BatchActionMoveToList && workspaceID != nil {
+		if serviceErr := s.checkWorkspaceMemberWithTransaction(ctx, tx, userID, *workspaceID); serviceErr != nil {
+			tx.Rollback()
+			return BatchAssignmentResult{}, serviceErr
+		}
+	}
+
+	assignments, err := s.assignmentRepository.FindAssignmentsByIDsForUpdateWithTransaction(ctx, tx, request.IDs)
+	if err != nil {
+		tx.Rollback()
+		return BatchAssignmentResult{}, NewInternalServiceError(err)
+	}
+
+	assignmentsByID := make(map[int32]Assignment, len(assignments))
+	for _, assignment := range assignments {
+		assignmentsByID[assignment.ID] = assignment
+	}
+
+	roles, err := s.batchAssignmentRolesWithTransaction(ctx, tx, userID, assignments)
+	if err != nil {
+		tx.Rollback()
+		return BatchAssignmentResult{}, NewInternalServiceError(err)
+	}
+
+	required := CollaboratorRoleEditor
+	if request.Action == BatchActionDelete || request.Action == BatchActionMoveToList {
+		required = CollaboratorRoleOwner
+	}
+
+	result := BatchAssignmentResult{
+		Action:  request.Action,
+		Results: make([]BatchItemResult, len(request.IDs)),
+	}
+	changed := make([]int32, 0, len(request.IDs))
+
+	for i, id := range request.IDs {
+		result.Results[i].ID = id
+
+		assignment, ok := assignmentsByID[id]
+		switch {
+		case !ok:
+			result.Results[i].Status = BatchItemStatusNotFound
+			result.Failed++
+			continue
+		case !roles[id].allows(required):
+			result.Results[i].Status = BatchItemStatusForbidden
+			result.Failed++
+			continue
+		case request.Action == BatchActionComplete && assignment.IsBlocked && !request.Force && batchActionChanges(request, assignment):
+			result.Results[i].Status = BatchItemStatusBlocked
+			result.Failed++
+			continue
+		}
+
+		result.Succeeded++
+		if !batchActionChanges(request, assignment) {
+			result.Results[i].Status = BatchItemStatusUnchanged
+			continue
+		}
+
+		result.Results[i].Status = BatchItemStatusOK
+		changed = append(changed, id)
+	}
+
+	if len(changed) == 0 {
+		tx.Rollback()
+		return result, nil
+	}
+
+	var event missionservice.TriggerMissionEvent
+	var action AssignmentChangeAction
+
+	switch request.Action {
+	case BatchActionComplete:
+		err = s.assignmentRepository.UpdateAssignmentsIsCompletedWithTransaction(ctx, tx, changed, true)
+		event = missionservice.TriggerMissionEvent_MISSION_EVENT_DONE_ASSIGNMENT
+		action = AssignmentChangeActionComplete
+	case BatchActionUncomplete:
+		err = s.assignmentRepository.UpdateAssignmentsIsCompletedWithTransaction(ctx, tx, changed, false)
+		event = missionservice.TriggerMissionEvent_MISSION_EVENT_UNDONE_ASSIGNMENT
+		action = AssignmentChangeActionUncomplete
+	case BatchActionSetImportant:
+		err = s.assignmentRepository.UpdateAssignmentsIsImportantWithTransaction(ctx, tx, changed, *request.IsImportant)
+		action = AssignmentChangeActionUpdate
+	case BatchActionMoveToList:
+		err = s.assignmentRepository.UpdateAssignmentsWorkspaceIDWithTransaction(ctx, tx, changed, request.targetWorkspaceID())
+		action = AssignmentChangeActionUpdate
+	case BatchActionDelete:
+		err = s.assignmentRepository.SoftDeleteAssignmentsByIDsWithTransaction(ctx, tx, changed)
+		event = missionservice.TriggerMissionEvent_MISSION_EVENT_DELETE_ASSIGNMENT
+		action = AssignmentChangeActionDelete
+	}
+	if err != nil {
+		tx.Rollback()
+		return BatchAssignmentResult{}, NewInternalServiceError(err)
+	}
+
+	changes := make([]AssignmentChange, len(changed))
+	for i, id := range changed {
+		before := assignmentsByID[id]
+		changes[i] = newAssignmentChange(userID, action, &before, batchActionResult(request, before))
+	}
+
+	if err := s.recordAssignmentChangesWithTransaction(ctx, tx, changes); err != nil {
+		tx.Rollback()
+		return BatchAssignmentResult{}, NewInternalServiceError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return BatchAssignmentResult{}, NewInternalServiceError(err)
+	}
+
+	if event != missionservice.TriggerMissionEvent_MISSION_EVENT_UNKNOWN {
+		now := time.Now()
+		events := make([]*missionservice.MissionEvent, len(changed))
+		for i, id := range changed {
+			events[i] = newAssignmentMissionEvent(event, batchActionResult(request, assignmentsByID[id]), now)
+		}
+
+		if err := s.triggerMissionEvents(ctx, userID, events); err != nil {
+			return BatchAssignmentResult{}, NewInternalServiceError(err)
+		}
+	}
+
+	return result, nil
+}
+
+func batchActionResult(request BatchAssignmentRequest, assignment Assignment) Assignment {
+	assignment.Version++
+
+	switch request.Action {
+	case BatchActionComplete, BatchActionUncomplete:
+		isCompleted := request.Action == BatchActionComplete
+		assignment.IsCompleted = &isCompleted
+	case BatchActionSetImportant:
+		assignment.IsImportant = request.IsImportant
+	case BatchActionMoveToList:
+		assignment.WorkspaceID = request.targetWorkspaceID()
+	case BatchActionDelete:
+		now := time.Now()
+		assignment.DeletedAt = &now
+	}
+
+	return assignment
+}
+
+func batchActionChanges(request BatchAssignmentRequest, assignment Assignment) bool {
+	isCompleted := assignment.IsCompleted != nil && *assignment.IsCompleted
+	isImportant := assignment.IsImportant != nil && *assignment.IsImportant
+
+	switch request.Action {
+	case BatchActionComplete:
+		return !isCompleted
+	case BatchActionUncomplete:
+		return isCompleted
+	case BatchActionSetImportant:
+		return isImportant != *request.IsImportant
+	case BatchActionMoveToList:
+		workspaceID := request.targetWorkspaceID()
+		if assignment.WorkspaceID == nil || workspaceID == nil {
+			return assignment.WorkspaceID != workspaceID
+		}
+		return *assignment.WorkspaceID != *workspaceID
+	default:
+		return true
+	}
+}
+
+func (s *Service) batchAssignmentRolesWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID, assignments []Assignment) (map[int32]CollaboratorRole, error) {
+	roles := make(map[int32]CollaboratorRole, len(assignments))
+	shared := make([]int32, 0, len(assignments))
+
+	for _, assignment := range assignments {
+		if assignment.UserID == userID {
+			roles[assignment.ID] = CollaboratorRoleOwner
+			continue
+		}
+		shared = append(shared, assignment.ID)
+	}
+
+	if len(shared) == 0 {
+		return roles, nil
+	}
+
+	collaborators, err := s.collaboratorRepository.FindAcceptedCollaboratorsByUserIDAndAssignmentIDsWithTransaction(ctx, tx, userID, shared)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, collaborator := range collaborators {
+		roles[collaborator.AssignmentID] = collaborator.Role
+	}
+
+	return roles, nil
+}
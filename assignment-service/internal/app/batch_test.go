@@ -0,0 +1,55 @@
+package app
+
+import "testing"
+
+func TestBatchMoveToList(t *testing.T) {
+	workspaceID := int32(7)
+	otherWorkspaceID := int32(9)
+	personal := int32(0)
+
+	tests := []struct {
+		name    string
+		current *int32
+		target  *int32
+		changes bool
+		want    *int32
+	}{
+		{name: "personal to workspace", current: nil, target: &workspaceID, changes: true, want: &workspaceID},
+		{name: "workspace to personal", current: &workspaceID, target: &personal, changes: true, want: nil},
+		{name: "workspace to other workspace", current: &workspaceID, target: &otherWorkspaceID, changes: true, want: &otherWorkspaceID},
+		{name: "already in workspace", current: &workspaceID, target: &workspaceID, changes: false, want: &workspaceID},
+		{name: "already personal", current: nil, target: &personal, changes: false, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := BatchAssignmentRequest{Action: BatchActionMoveToList, WorkspaceID: tt.target}
+			before := Assignment{ID: 1, Title: "Essay", Version: 3, WorkspaceID: tt.current}
+
+			if got := batchActionChanges(request, before); got != tt.changes {
+				t.Fatalf("batchActionChanges() = %v, want %v", got, tt.changes)
+			}
+
+			after := batchActionResult(request, before)
+			if after.Version != before.Version+1 {
+				t.Errorf("version = %d, want %d", after.Version, before.Version+1)
+			}
+			if (after.WorkspaceID == nil) != (tt.want == nil) || (tt.want != nil && *after.WorkspaceID != *tt.want) {
+				t.Errorf("workspace_id = %v, want %v", after.WorkspaceID, tt.want)
+			}
+
+			diff := diffAssignments(&before, after)
+			if _, ok := diff["workspace_id"]; ok != tt.changes {
+				t.Fatalf("diff = %v, want workspace_id change %v", diff, tt.changes)
+			}
+
+			reverted := after
+			if err := diff.revert(&reverted); err != nil {
+				t.Fatal(err)
+			}
+			if (reverted.WorkspaceID == nil) != (before.WorkspaceID == nil) || (before.WorkspaceID != nil && *reverted.WorkspaceID != *before.WorkspaceID) {
+				t.Errorf("reverted workspace_id = %v, want %v", reverted.WorkspaceID, before.WorkspaceID)
+			}
+		})
+	}
+}
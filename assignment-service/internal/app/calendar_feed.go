@@ -0,0 +1,71 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CalendarFeed struct {
+	UserID    uuid.UUID  `json:"user_id" sql:"primary_key"`
+	Token     string     `json:"-"`
+	URL       string     `json:"url"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+type CalendarComponent string
+
+const (
+	CalendarComponentEvent CalendarComponent = "VEVENT"
+	CalendarComponentTodo  CalendarComponent = "VTODO"
+)
+
+type RenderedCalendarFeed struct {
+	Body        []byte
+	ETag        string
+	NotModified bool
+}
+
+type CalendarFeedVersion struct {
+	Assignments          int64
+	Reminders            int64
+	AssignmentsUpdatedAt *time.Time
+	RemindersUpdatedAt   *time.Time
+}
+
+func (v CalendarFeedVersion) etag(token string, component CalendarComponent) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s|%s|%d|%d|%s|%s",
+		token,
+		component,
+		v.Assignments,
+		v.Reminders,
+		formatVersionTime(v.AssignmentsUpdatedAt),
+		formatVersionTime(v.RemindersUpdatedAt),
+	))
+
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+func formatVersionTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var CalendarFeedTable = table.CalendarFeeds.AS("calendar_feed")
+
+type CalendarFeedRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewCalendarFeedRepository(db *database.Service, tracer trace.Tracer) *CalendarFeedRepository {
+	return &CalendarFeedRepository{db, tracer}
+}
+
+func (r *CalendarFeedRepository) UpsertCalendarFeed(ctx context.Context, userID uuid.UUID, token string) (CalendarFeed, error) {
+	ctx, span := r.tracer.Start(ctx, "CalendarFeedRepository.UpsertCalendarFeed")
+	defer span.End()
+
+	query := CalendarFeedTable.INSERT(CalendarFeedTable.UserID, CalendarFeedTable.Token).
+		VALUES(userID, token).
+		ON_CONFLICT(CalendarFeedTable.UserID).
+		DO_UPDATE(SET(
+			CalendarFeedTable.Token.SET(CalendarFeedTable.EXCLUDED.Token),
+			CalendarFeedTable.UpdatedAt.SET(LOCALTIMESTAMP()),
+		)).
+		RETURNING(CalendarFeedTable.AllColumns)
+
+	var f CalendarFeed
+	err := query.QueryContext(ctx, r.db.Pool, &f)
+
+	return f, err
+}
+
+func (r *CalendarFeedRepository) FindCalendarFeedByUserID(ctx context.Context, userID uuid.UUID) (CalendarFeed, error) {
+	ctx, span := r.tracer.Start(ctx, "CalendarFeedRepository.FindCalendarFeedByUserID")
+	defer span.End()
+
+	query := CalendarFeedTable.SELECT(CalendarFeedTable.AllColumns).
+		FROM(CalendarFeedTable).
+		WHERE(CalendarFeedTable.UserID.EQ(UUID(userID)))
+
+	var f CalendarFeed
+	err := query.QueryContext(ctx, r.db.Pool, &f)
+
+	return f, err
+}
+
+func (r *CalendarFeedRepository) FindCalendarFeedByToken(ctx context.Context, token string) (CalendarFeed, error) {
+	ctx, span := r.tracer.Start(ctx, "CalendarFeedRepository.FindCalendarFeedByToken")
+	defer span.End()
+
+	query := CalendarFeedTable.SELECT(CalendarFeedTable.AllColumns).
+		FROM(CalendarFeedTable).
+		WHERE(CalendarFeedTable.Token.EQ(String(token)))
+
+	var f CalendarFeed
+	err := query.QueryContext(ctx, r.db.Pool, &f)
+
+	return f, err
+}
+
+func (r *CalendarFeedRepository) DeleteCalendarFeedByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "CalendarFeedRepository.DeleteCalendarFeedByUserID")
+	defer span.End()
+
+	query := CalendarFeedTable.DELETE().
+		WHERE(CalendarFeedTable.UserID.EQ(UUID(userID)))
+
+	result, err := query.ExecContext(ctx, r.db.Pool)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
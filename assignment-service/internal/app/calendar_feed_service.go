@@ -0,0 +1,116 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const calendarFeedTokenLength = 32
+
+func (s *Service) GetCalendarFeed(ctx context.Context, userID uuid.UUID) (CalendarFeed, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetCalendarFeed")
+	defer span.End()
+
+	feed, err := s.calendarFeedRepository.FindCalendarFeedByUserID(ctx, userID)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return CalendarFeed{}, NewClientError(http.StatusNotFound, errors.New("calendar feed not found"))
+	}
+	if err != nil {
+		return CalendarFeed{}, NewInternalServiceError(err)
+	}
+
+	s.setCalendarFeedURL(&feed)
+
+	return feed, nil
+}
+
+func (s *Service) RotateCalendarFeed(ctx context.Context, userID uuid.UUID) (CalendarFeed, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.RotateCalendarFeed")
+	defer span.End()
+
+	token, err := generateCalendarFeedToken()
+	if err != nil {
+		return CalendarFeed{}, NewInternalServiceError(err)
+	}
+
+	feed, err := s.calendarFeedRepository.UpsertCalendarFeed(ctx, userID, token)
+	if err != nil {
+		return CalendarFeed{}, NewInternalServiceError(err)
+	}
+
+	s.setCalendarFeedURL(&feed)
+
+	return feed, nil
+}
+
+func (s *Service) RevokeCalendarFeed(ctx context.Context, userID uuid.UUID) ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.RevokeCalendarFeed")
+	defer span.End()
+
+	deleted, err := s.calendarFeedRepository.DeleteCalendarFeedByUserID(ctx, userID)
+	if err != nil {
+		return NewInternalServiceError(err)
+	}
+	if deleted == 0 {
+		return NewClientError(http.StatusNotFound, errors.New("calendar feed not found"))
+	}
+
+	return nil
+}
+
+func (s *Service) RenderCalendarFeed(ctx context.Context, token string, component CalendarComponent, ifNoneMatch string) (RenderedCalendarFeed, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.RenderCalendarFeed")
+	defer span.End()
+
+	feed, err := s.calendarFeedRepository.FindCalendarFeedByToken(ctx, token)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return RenderedCalendarFeed{}, NewClientError(http.StatusNotFound, errors.New("calendar feed not found"))
+	}
+	if err != nil {
+		return RenderedCalendarFeed{}, NewInternalServiceError(err)
+	}
+
+	version, err := s.assignmentRepository.FindCalendarFeedVersionByUserID(ctx, feed.UserID)
+	if err != nil {
+		return RenderedCalendarFeed{}, NewInternalServiceError(err)
+	}
+
+	etag := version.etag(feed.Token, component)
+	if etagMatches(ifNoneMatch, etag) {
+		return RenderedCalendarFeed{ETag: etag, NotModified: true}, nil
+	}
+
+	assignments, err := s.assignmentRepository.FindAssignmentsByUserIDJoinReminders(ctx, feed.UserID)
+	if err != nil {
+		return RenderedCalendarFeed{}, NewInternalServiceError(err)
+	}
+
+	_, renderingSpan := s.tracer.Start(ctx, "rendering calendar")
+	body := renderCalendar(assignments, component, time.Now())
+	renderingSpan.End()
+
+	return RenderedCalendarFeed{
+		Body: body,
+		ETag: etag,
+	}, nil
+}
+
+func (s *Service) setCalendarFeedURL(feed *CalendarFeed) {
+	feed.URL = s.calendarFeedEndpoint + feed.Token + ".ics"
+}
+
+func generateCalendarFeedToken() (string, error) {
+	b := make([]byte, calendarFeedTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
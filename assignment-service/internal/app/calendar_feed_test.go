@@ -0,0 +1,165 @@
+package app
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalendarFeedVersionETag(t *testing.T) {
+	assignmentsUpdatedAt := time.Date(2026, time.March, 7, 10, 0, 0, 0, time.UTC)
+	remindersUpdatedAt := time.Date(2026, time.March, 7, 11, 0, 0, 0, time.UTC)
+	later := remindersUpdatedAt.Add(time.Microsecond)
+	assignmentsUpdatedAtInJakarta := assignmentsUpdatedAt.In(time.FixedZone("WIB", 7*60*60))
+
+	base := CalendarFeedVersion{
+		Assignments:          3,
+		Reminders:            2,
+		AssignmentsUpdatedAt: &assignmentsUpdatedAt,
+		RemindersUpdatedAt:   &remindersUpdatedAt,
+	}
+	baseETag := base.etag("token", CalendarComponentEvent)
+
+	tests := []struct {
+		name      string
+		version   CalendarFeedVersion
+		token     string
+		component CalendarComponent
+		changed   bool
+	}{
+		{
+			name:      "same state",
+			version:   base,
+			token:     "token",
+			component: CalendarComponentEvent,
+			changed:   false,
+		},
+		{
+			name: "same instant in another zone",
+			version: CalendarFeedVersion{
+				Assignments:          3,
+				Reminders:            2,
+				AssignmentsUpdatedAt: &assignmentsUpdatedAtInJakarta,
+				RemindersUpdatedAt:   &remindersUpdatedAt,
+			},
+			token:     "token",
+			component: CalendarComponentEvent,
+			changed:   false,
+		},
+		{
+			name: "assignment deleted without touching the latest update",
+			version: CalendarFeedVersion{
+				Assignments:          2,
+				Reminders:            2,
+				AssignmentsUpdatedAt: &assignmentsUpdatedAt,
+				RemindersUpdatedAt:   &remindersUpdatedAt,
+			},
+			token:     "token",
+			component: CalendarComponentEvent,
+			changed:   true,
+		},
+		{
+			name: "reminder deleted without touching the latest update",
+			version: CalendarFeedVersion{
+				Assignments:          3,
+				Reminders:            1,
+				AssignmentsUpdatedAt: &assignmentsUpdatedAt,
+				RemindersUpdatedAt:   &remindersUpdatedAt,
+			},
+			token:     "token",
+			component: CalendarComponentEvent,
+			changed:   true,
+		},
+		{
+			name: "assignment updated",
+			version: CalendarFeedVersion{
+				Assignments:          3,
+				Reminders:            2,
+				AssignmentsUpdatedAt: &later,
+				RemindersUpdatedAt:   &remindersUpdatedAt,
+			},
+			token:     "token",
+			component: CalendarComponentEvent,
+			changed:   true,
+		},
+		{
+			name: "reminder updated",
+			version: CalendarFeedVersion{
+				Assignments:          3,
+				Reminders:            2,
+				AssignmentsUpdatedAt: &assignmentsUpdatedAt,
+				RemindersUpdatedAt:   &later,
+			},
+			token:     "token",
+			component: CalendarComponentEvent,
+			changed:   true,
+		},
+		{
+			name: "last reminder deleted",
+			version: CalendarFeedVersion{
+				Assignments:          3,
+				AssignmentsUpdatedAt: &assignmentsUpdatedAt,
+			},
+			token:     "token",
+			component: CalendarComponentEvent,
+			changed:   true,
+		},
+		{
+			name:      "other component",
+			version:   base,
+			token:     "token",
+			component: CalendarComponentTodo,
+			changed:   true,
+		},
+		{
+			name:      "rotated token",
+			version:   base,
+			token:     "rotated",
+			component: CalendarComponentEvent,
+			changed:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			etag := tt.version.etag(tt.token, tt.component)
+			if changed := etag != baseETag; changed != tt.changed {
+				t.Errorf("etag = %s, base = %s, want changed %v", etag, baseETag, tt.changed)
+			}
+		})
+	}
+}
+
+func TestCalendarFeedVersionETagIsEmptyFeedSafe(t *testing.T) {
+	empty := CalendarFeedVersion{}
+
+	etag := empty.etag("token", CalendarComponentEvent)
+	if len(etag) != 34 || etag[0] != '"' || etag[len(etag)-1] != '"' {
+		t.Errorf("etag = %s, want a quoted 32 character hex digest", etag)
+	}
+}
+
+func TestETagMatches(t *testing.T) {
+	etag := `"0123456789abcdef0123456789abcdef"`
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        bool
+	}{
+		{name: "empty", ifNoneMatch: "", want: false},
+		{name: "exact", ifNoneMatch: etag, want: true},
+		{name: "weak", ifNoneMatch: "W/" + etag, want: true},
+		{name: "wildcard", ifNoneMatch: "*", want: true},
+		{name: "list", ifNoneMatch: `"stale", ` + etag, want: true},
+		{name: "other", ifNoneMatch: `"stale"`, want: false},
+		{name: "unquoted", ifNoneMatch: "0123456789abcdef0123456789abcdef", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := etagMatches(tt.ifNoneMatch, etag); got != tt.want {
+				t.Errorf("etagMatches(%q) = %v, want %v", tt.ifNoneMatch, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CollaboratorRole string
+
+const (
+	CollaboratorRoleViewer CollaboratorRole = "viewer"
+	CollaboratorRoleEditor CollaboratorRole = "editor"
+	CollaboratorRoleOwner  CollaboratorRole = "owner"
+)
+
+var collaboratorRoleRanks = map[CollaboratorRole]int{
+	CollaboratorRoleViewer: 1,
+	CollaboratorRoleEditor: 2,
+	CollaboratorRoleOwner:  3,
+}
+
+func (r CollaboratorRole) allows(required CollaboratorRole) bool {
+	return collaboratorRoleRanks[r] >= collaboratorRoleRanks[required]
+}
+
+type Collaborator struct {
+	ID           int32            `json:"id" sql:"primary_key"`
+	AssignmentID int32            `json:"assignment_id"`
+	UserID       uuid.UUID        `json:"user_id"`
+	Role         CollaboratorRole `json:"role"`
+	InvitedBy    uuid.UUID        `json:"invited_by"`
+	AcceptedAt   *time.Time       `json:"accepted_at"`
+	CreatedAt    *time.Time       `json:"created_at"`
+	UpdatedAt    *time.Time       `json:"updated_at"`
+	Assignment   *Assignment      `json:"assignment,omitempty"`
+}
+
+type InviteCollaboratorRequest struct {
+	Email  string           `json:"email" validate:"required_without=UserID,omitempty,email"`
+	UserID string           `json:"user_id" validate:"required_without=Email,omitempty,uuid"`
+	Role   CollaboratorRole `json:"role" validate:"required,oneof=viewer editor owner"`
+}
+
+type UpdateCollaboratorRequest struct {
+	Role CollaboratorRole `json:"role" validate:"required,oneof=viewer editor owner"`
+}
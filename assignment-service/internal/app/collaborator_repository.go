@@ -0,0 +1,161 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var CollaboratorTable = table.AssignmentCollaborators.AS("collaborator")
+
+type CollaboratorRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewCollaboratorRepository(db *database.Service, tracer trace.Tracer) *CollaboratorRepository {
+	return &CollaboratorRepository{db, tracer}
+}
+
+func (r *CollaboratorRepository) InsertCollaborator(ctx context.Context, collaborator Collaborator) (Collaborator, error) {
+	ctx, span := r.tracer.Start(ctx, "CollaboratorRepository.InsertCollaborator")
+	defer span.End()
+
+	query := CollaboratorTable.INSERT(
+		CollaboratorTable.AssignmentID,
+		CollaboratorTable.UserID,
+		CollaboratorTable.Role,
+		CollaboratorTable.InvitedBy,
+	).
+		VALUES(collaborator.AssignmentID, collaborator.UserID, collaborator.Role, collaborator.InvitedBy).
+		RETURNING(CollaboratorTable.AllColumns)
+
+	var c Collaborator
+	err := query.QueryContext(ctx, r.db.Pool, &c)
+
+	return c, err
+}
+
+func (r *CollaboratorRepository) FindCollaboratorsByAssignmentID(ctx context.Context, assignmentID int32) ([]Collaborator, error) {
+	ctx, span := r.tracer.Start(ctx, "CollaboratorRepository.FindCollaboratorsByAssignmentID")
+	defer span.End()
+
+	query := CollaboratorTable.SELECT(CollaboratorTable.AllColumns).
+		FROM(CollaboratorTable).
+		WHERE(CollaboratorTable.AssignmentID.EQ(Int32(assignmentID))).
+		ORDER_BY(CollaboratorTable.CreatedAt.ASC())
+
+	collaborators := []Collaborator{}
+	err := query.QueryContext(ctx, r.db.Pool, &collaborators)
+
+	return collaborators, err
+}
+
+func (r *CollaboratorRepository) FindCollaboratorByAssignmentIDAndUserID(ctx context.Context, assignmentID int32, userID uuid.UUID) (Collaborator, error) {
+	ctx, span := r.tracer.Start(ctx, "CollaboratorRepository.FindCollaboratorByAssignmentIDAndUserID")
+	defer span.End()
+
+	query := CollaboratorTable.SELECT(CollaboratorTable.AllColumns).
+		FROM(CollaboratorTable).
+		WHERE(CollaboratorTable.AssignmentID.EQ(Int32(assignmentID)).AND(CollaboratorTable.UserID.EQ(UUID(userID))))
+
+	var c Collaborator
+	err := query.QueryContext(ctx, r.db.Pool, &c)
+
+	return c, err
+}
+
+func (r *CollaboratorRepository) FindAcceptedCollaboratorsByUserIDAndAssignmentIDsWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID, assignmentIDs []int32) ([]Collaborator, error) {
+	ctx, span := r.tracer.Start(ctx, "CollaboratorRepository.FindAcceptedCollaboratorsByUserIDAndAssignmentIDsWithTransaction")
+	defer span.End()
+
+	query := CollaboratorTable.SELECT(CollaboratorTable.AllColumns).
+		FROM(CollaboratorTable).
+		WHERE(
+			CollaboratorTable.UserID.EQ(UUID(userID)).
+				AND(CollaboratorTable.AssignmentID.IN(int32Expressions(assignmentIDs)...)).
+				AND(CollaboratorTable.AcceptedAt.IS_NOT_NULL()),
+		).
+		FOR(SHARE())
+
+	collaborators := []Collaborator{}
+	err := query.QueryContext(ctx, tx, &collaborators)
+
+	return collaborators, err
+}
+
+func (r *CollaboratorRepository) FindPendingCollaboratorsByUserIDJoinAssignment(ctx context.Context, userID uuid.UUID) ([]Collaborator, error) {
+	ctx, span := r.tracer.Start(ctx, "CollaboratorRepository.FindPendingCollaboratorsByUserIDJoinAssignment")
+	defer span.End()
+
+	query := CollaboratorTable.SELECT(CollaboratorTable.AllColumns, AssignmentColumns).
+		FROM(CollaboratorTable.INNER_JOIN(AssignmentTable, CollaboratorTable.AssignmentID.EQ(AssignmentTable.ID))).
+		WHERE(
+			CollaboratorTable.UserID.EQ(UUID(userID)).
+				AND(CollaboratorTable.AcceptedAt.IS_NULL()).
+				AND(AssignmentTable.DeletedAt.IS_NULL()),
+		).
+		ORDER_BY(CollaboratorTable.CreatedAt.DESC())
+
+	collaborators := []Collaborator{}
+	err := query.QueryContext(ctx, r.db.Pool, &collaborators)
+
+	return collaborators, err
+}
+
+func (r *CollaboratorRepository) AcceptCollaborator(ctx context.Context, assignmentID int32, userID uuid.UUID) (Collaborator, error) {
+	ctx, span := r.tracer.Start(ctx, "CollaboratorRepository.AcceptCollaborator")
+	defer span.End()
+
+	query := CollaboratorTable.UPDATE(CollaboratorTable.AcceptedAt, CollaboratorTable.UpdatedAt).
+		SET(time.Now(), time.Now()).
+		WHERE(
+			CollaboratorTable.AssignmentID.EQ(Int32(assignmentID)).
+				AND(CollaboratorTable.UserID.EQ(UUID(userID))).
+				AND(CollaboratorTable.AcceptedAt.IS_NULL()),
+		).
+		RETURNING(CollaboratorTable.AllColumns)
+
+	var c Collaborator
+	err := query.QueryContext(ctx, r.db.Pool, &c)
+
+	return c, err
+}
+
+func (r *CollaboratorRepository) UpdateCollaboratorRole(ctx context.Context, assignmentID int32, userID uuid.UUID, role CollaboratorRole) (Collaborator, error) {
+	ctx, span := r.tracer.Start(ctx, "CollaboratorRepository.UpdateCollaboratorRole")
+	defer span.End()
+
+	query := CollaboratorTable.UPDATE(CollaboratorTable.Role, CollaboratorTable.UpdatedAt).
+		SET(role, time.Now()).
+		WHERE(CollaboratorTable.AssignmentID.EQ(Int32(assignmentID)).AND(CollaboratorTable.UserID.EQ(UUID(userID)))).
+		RETURNING(CollaboratorTable.AllColumns)
+
+	var c Collaborator
+	err := query.QueryContext(ctx, r.db.Pool, &c)
+
+	return c, err
+}
+
+func (r *CollaboratorRepository) DeleteCollaborator(ctx context.Context, assignmentID int32, userID uuid.UUID) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "CollaboratorRepository.DeleteCollaborator")
+	defer span.End()
+
+	query := CollaboratorTable.DELETE().
+		WHERE(CollaboratorTable.AssignmentID.EQ(Int32(assignmentID)).AND(CollaboratorTable.UserID.EQ(UUID(userID))))
+
+	result, err := query.ExecContext(ctx, r.db.Pool)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,156 @@
+package app
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) GetCollaborators(ctx context.Context, userID uuid.UUID, assignmentID int32) ([]Collaborator, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetCollaborators")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleViewer)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	collaborators, err := s.collaboratorRepository.FindCollaboratorsByAssignmentID(ctx, assignment.ID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return collaborators, nil
+}
+
+func (s *Service) InviteCollaborator(ctx context.Context, userID uuid.UUID, assignmentID int32, request InviteCollaboratorRequest) (Collaborator, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.InviteCollaborator")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleOwner)
+	if serviceErr != nil {
+		return Collaborator{}, serviceErr
+	}
+
+	inviteeID, serviceErr := s.resolveUser(ctx, request.Email, request.UserID)
+	if serviceErr != nil {
+		return Collaborator{}, serviceErr
+	}
+	if inviteeID == assignment.UserID || inviteeID == userID {
+		return Collaborator{}, NewClientError(http.StatusConflict, errors.New("user already has access to assignment"))
+	}
+
+	_, err := s.collaboratorRepository.FindCollaboratorByAssignmentIDAndUserID(ctx, assignment.ID, inviteeID)
+	if err == nil {
+		return Collaborator{}, NewClientError(http.StatusConflict, errors.New("user is already a collaborator"))
+	}
+	if !errors.Is(err, qrm.ErrNoRows) {
+		return Collaborator{}, NewInternalServiceError(err)
+	}
+
+	collaborator, err := s.collaboratorRepository.InsertCollaborator(ctx, Collaborator{
+		AssignmentID: assignment.ID,
+		UserID:       inviteeID,
+		Role:         request.Role,
+		InvitedBy:    userID,
+	})
+	if err != nil {
+		return Collaborator{}, NewInternalServiceError(err)
+	}
+
+	return collaborator, nil
+}
+
+func (s *Service) UpdateCollaboratorRole(ctx context.Context, userID uuid.UUID, assignmentID int32, collaboratorID uuid.UUID, request UpdateCollaboratorRequest) (Collaborator, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.UpdateCollaboratorRole")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleOwner)
+	if serviceErr != nil {
+		return Collaborator{}, serviceErr
+	}
+
+	collaborator, err := s.collaboratorRepository.UpdateCollaboratorRole(ctx, assignment.ID, collaboratorID, request.Role)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return Collaborator{}, NewClientError(http.StatusNotFound, errors.New("collaborator not found"))
+	}
+	if err != nil {
+		return Collaborator{}, NewInternalServiceError(err)
+	}
+
+	return collaborator, nil
+}
+
+func (s *Service) RemoveCollaborator(ctx context.Context, userID uuid.UUID, assignmentID int32, collaboratorID uuid.UUID) ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.RemoveCollaborator")
+	defer span.End()
+
+	required := CollaboratorRoleOwner
+	if collaboratorID == userID {
+		required = CollaboratorRoleViewer
+	}
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, required)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	deleted, err := s.collaboratorRepository.DeleteCollaborator(ctx, assignment.ID, collaboratorID)
+	if err != nil {
+		return NewInternalServiceError(err)
+	}
+	if deleted == 0 {
+		return NewClientError(http.StatusNotFound, errors.New("collaborator not found"))
+	}
+
+	return nil
+}
+
+func (s *Service) GetInvitations(ctx context.Context, userID uuid.UUID) ([]Collaborator, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetInvitations")
+	defer span.End()
+
+	invitations, err := s.collaboratorRepository.FindPendingCollaboratorsByUserIDJoinAssignment(ctx, userID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return invitations, nil
+}
+
+func (s *Service) AcceptInvitation(ctx context.Context, userID uuid.UUID, assignmentID int32) (Collaborator, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.AcceptInvitation")
+	defer span.End()
+
+	collaborator, err := s.collaboratorRepository.AcceptCollaborator(ctx, assignmentID, userID)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return Collaborator{}, NewClientError(http.StatusNotFound, errors.New("invitation not found"))
+	}
+	if err != nil {
+		return Collaborator{}, NewInternalServiceError(err)
+	}
+
+	return collaborator, nil
+}
+
+func (s *Service) DeclineInvitation(ctx context.Context, userID uuid.UUID, assignmentID int32) ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.DeclineInvitation")
+	defer span.End()
+
+	collaborator, err := s.collaboratorRepository.FindCollaboratorByAssignmentIDAndUserID(ctx, assignmentID, userID)
+	if errors.Is(err, qrm.ErrNoRows) || (err == nil && collaborator.AcceptedAt != nil) {
+		return NewClientError(http.StatusNotFound, errors.New("invitation not found"))
+	}
+	if err != nil {
+		return NewInternalServiceError(err)
+	}
+
+	if _, err := s.collaboratorRepository.DeleteCollaborator(ctx, assignmentID, userID); err != nil {
+		return NewInternalServiceError(err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package app
 type ServiceError interface {
 	Error() string
 	Code() int
+	Data() any
 }
 
 type InternalServiceError struct {
@@ -17,6 +18,10 @@ func (e InternalServiceError) Error() string {
 	return e.err.Error()
 }
 
+func (e InternalServiceError) Data() any {
+	return nil
+}
+
 func NewInternalServiceError(err error) ServiceError {
 	return &InternalServiceError{err}
 }
@@ -24,6 +29,7 @@ func NewInternalServiceError(err error) ServiceError {
 type ClientError struct {
 	code int
 	err  error
+	data any
 }
 
 func (e ClientError) Code() int {
@@ -34,6 +40,14 @@ func (e ClientError) Error() string {
 	return e.err.Error()
 }
 
+func (e ClientError) Data() any {
+	return e.data
+}
+
 func NewClientError(code int, err error) ServiceError {
-	return &ClientError{code, err}
+	return &ClientError{code, err, nil}
+}
+
+func NewClientErrorWithData(code int, err error, data any) ServiceError {
+	return &ClientError{code, err, data}
 }
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FocusSessionKind string
+
+const (
+	FocusSessionKindTimer    FocusSessionKind = "timer"
+	FocusSessionKindPomodoro FocusSessionKind = "pomodoro"
+)
+
+const (
+	focusSessionDefaultPomodoroMinutes = 25
+	focusSessionMinTimerDuration       = time.Minute
+)
+
+type FocusSession struct {
+	ID                     int32            `json:"id" sql:"primary_key"`
+	AssignmentID           int32            `json:"assignment_id"`
+	UserID                 uuid.UUID        `json:"user_id"`
+	Kind                   FocusSessionKind `json:"kind"`
+	PlannedDurationSeconds *int32           `json:"planned_duration_seconds"`
+	StartedAt              time.Time        `json:"started_at"`
+	EndedAt                *time.Time       `json:"ended_at"`
+	DurationSeconds        *int32           `json:"duration_seconds"`
+	Completed              bool             `json:"completed"`
+	CreatedAt              *time.Time       `json:"created_at"`
+	UpdatedAt              *time.Time       `json:"updated_at"`
+}
+
+func (f FocusSession) stop(now time.Time) FocusSession {
+	duration := now.Sub(f.StartedAt).Truncate(time.Second)
+	durationSeconds := int32(duration / time.Second)
+
+	f.EndedAt = &now
+	f.DurationSeconds = &durationSeconds
+
+	switch f.Kind {
+	case FocusSessionKindPomodoro:
+		f.Completed = f.PlannedDurationSeconds != nil && durationSeconds >= *f.PlannedDurationSeconds
+	default:
+		f.Completed = duration >= focusSessionMinTimerDuration
+	}
+
+	return f
+}
+
+type StartFocusSessionRequest struct {
+	Kind           FocusSessionKind `json:"kind" validate:"required,oneof=timer pomodoro"`
+	PlannedMinutes int32            `json:"planned_minutes" validate:"omitempty,min=1,max=240"`
+}
+
+type FocusTotalGroup string
+
+const (
+	FocusTotalGroupAssignment FocusTotalGroup = "assignment"
+	FocusTotalGroupDay        FocusTotalGroup = "day"
+	FocusTotalGroupTag        FocusTotalGroup = "tag"
+)
+
+type FocusTotal struct {
+	Key      string `json:"key"`
+	Seconds  int64  `json:"seconds"`
+	Sessions int64  `json:"sessions"`
+}
@@ -0,0 +1,161 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var FocusSessionTable = table.FocusSessions.AS("focus_session")
+
+type focusTotalQuery struct {
+	key     string
+	join    string
+	orderBy string
+}
+
+var focusTotalQueries = map[FocusTotalGroup]focusTotalQuery{
+	FocusTotalGroupAssignment: {
+		key:     "focus_sessions.assignment_id::text",
+		orderBy: "2 DESC, 1",
+	},
+	FocusTotalGroupDay: {
+		key:     "to_char(focus_sessions.started_at AT TIME ZONE #timezone, 'YYYY-MM-DD')",
+		orderBy: "1",
+	},
+	FocusTotalGroupTag: {
+		key:     "assignment_tags.tag",
+		join:    "JOIN assignment_tags ON assignment_tags.assignment_id = focus_sessions.assignment_id",
+		orderBy: "2 DESC, 1",
+	},
+}
+
+type FocusSessionRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewFocusSessionRepository(db *database.Service, tracer trace.Tracer) *FocusSessionRepository {
+	return &FocusSessionRepository{db, tracer}
+}
+
+func (r *FocusSessionRepository) InsertFocusSession(ctx context.Context, session FocusSession) (FocusSession, error) {
+	ctx, span := r.tracer.Start(ctx, "FocusSessionRepository.InsertFocusSession")
+	defer span.End()
+
+	query := FocusSessionTable.INSERT(
+		FocusSessionTable.AssignmentID,
+		FocusSessionTable.UserID,
+		FocusSessionTable.Kind,
+		FocusSessionTable.PlannedDurationSeconds,
+		FocusSessionTable.StartedAt,
+	).
+		VALUES(session.AssignmentID, session.UserID, session.Kind, session.PlannedDurationSeconds, session.StartedAt).
+		RETURNING(FocusSessionTable.AllColumns)
+
+	var inserted FocusSession
+	err := query.QueryContext(ctx, r.db.Pool, &inserted)
+
+	return inserted, err
+}
+
+func (r *FocusSessionRepository) FindFocusSessionByID(ctx context.Context, id int32) (FocusSession, error) {
+	ctx, span := r.tracer.Start(ctx, "FocusSessionRepository.FindFocusSessionByID")
+	defer span.End()
+
+	query := FocusSessionTable.SELECT(FocusSessionTable.AllColumns).
+		FROM(FocusSessionTable).
+		WHERE(FocusSessionTable.ID.EQ(Int32(id)))
+
+	var session FocusSession
+	err := query.QueryContext(ctx, r.db.Pool, &session)
+
+	return session, err
+}
+
+func (r *FocusSessionRepository) FindRunningFocusSessionByUserID(ctx context.Context, userID uuid.UUID) (FocusSession, error) {
+	ctx, span := r.tracer.Start(ctx, "FocusSessionRepository.FindRunningFocusSessionByUserID")
+	defer span.End()
+
+	query := FocusSessionTable.SELECT(FocusSessionTable.AllColumns).
+		FROM(FocusSessionTable).
+		WHERE(FocusSessionTable.UserID.EQ(UUID(userID)).AND(FocusSessionTable.EndedAt.IS_NULL()))
+
+	var session FocusSession
+	err := query.QueryContext(ctx, r.db.Pool, &session)
+
+	return session, err
+}
+
+func (r *FocusSessionRepository) FindFocusSessionsByAssignmentIDAndUserID(ctx context.Context, assignmentID int32, userID uuid.UUID) ([]FocusSession, error) {
+	ctx, span := r.tracer.Start(ctx, "FocusSessionRepository.FindFocusSessionsByAssignmentIDAndUserID")
+	defer span.End()
+
+	query := FocusSessionTable.SELECT(FocusSessionTable.AllColumns).
+		FROM(FocusSessionTable).
+		WHERE(FocusSessionTable.AssignmentID.EQ(Int32(assignmentID)).AND(FocusSessionTable.UserID.EQ(UUID(userID)))).
+		ORDER_BY(FocusSessionTable.StartedAt.DESC())
+
+	sessions := []FocusSession{}
+	err := query.QueryContext(ctx, r.db.Pool, &sessions)
+
+	return sessions, err
+}
+
+func (r *FocusSessionRepository) StopFocusSession(ctx context.Context, session FocusSession) (FocusSession, error) {
+	ctx, span := r.tracer.Start(ctx, "FocusSessionRepository.StopFocusSession")
+	defer span.End()
+
+	query := FocusSessionTable.UPDATE(
+		FocusSessionTable.EndedAt,
+		FocusSessionTable.DurationSeconds,
+		FocusSessionTable.Completed,
+		FocusSessionTable.UpdatedAt,
+	).
+		SET(session.EndedAt, session.DurationSeconds, session.Completed, time.Now()).
+		WHERE(FocusSessionTable.ID.EQ(Int32(session.ID)).AND(FocusSessionTable.EndedAt.IS_NULL())).
+		RETURNING(FocusSessionTable.AllColumns)
+
+	var stopped FocusSession
+	err := query.QueryContext(ctx, r.db.Pool, &stopped)
+
+	return stopped, err
+}
+
+func (r *FocusSessionRepository) FindFocusTotals(ctx context.Context, userID uuid.UUID, group FocusTotalGroup, timezone string) ([]FocusTotal, error) {
+	ctx, span := r.tracer.Start(ctx, "FocusSessionRepository.FindFocusTotals")
+	defer span.End()
+
+	totalQuery := focusTotalQueries[group]
+	args := RawArgs{
+		"#userID": userID,
+	}
+	if group == FocusTotalGroupDay {
+		args["#timezone"] = timezone
+	}
+
+	query := RawStatement(`
+		SELECT
+			`+totalQuery.key+` AS "focus_total.key",
+			COALESCE(SUM(focus_sessions.duration_seconds), 0)::bigint AS "focus_total.seconds",
+			COUNT(*) AS "focus_total.sessions"
+		FROM focus_sessions
+		JOIN assignments ON assignments.id = focus_sessions.assignment_id AND assignments.deleted_at IS NULL
+		`+totalQuery.join+`
+		WHERE focus_sessions.user_id = #userID AND focus_sessions.ended_at IS NOT NULL
+		GROUP BY 1
+		ORDER BY `+totalQuery.orderBy+`
+	`, args)
+
+	result := []FocusTotal{}
+	err := query.QueryContext(ctx, r.db.Pool, &result)
+
+	return result, err
+}
@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) StartFocusSession(ctx context.Context, userID uuid.UUID, assignmentID int32, request StartFocusSessionRequest) (FocusSession, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.StartFocusSession")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleViewer)
+	if serviceErr != nil {
+		return FocusSession{}, serviceErr
+	}
+
+	_, err := s.focusSessionRepository.FindRunningFocusSessionByUserID(ctx, userID)
+	if err == nil {
+		return FocusSession{}, NewClientError(http.StatusConflict, errors.New("a focus session is already running"))
+	}
+	if !errors.Is(err, qrm.ErrNoRows) {
+		return FocusSession{}, NewInternalServiceError(err)
+	}
+
+	session := FocusSession{
+		AssignmentID: assignment.ID,
+		UserID:       userID,
+		Kind:         request.Kind,
+		StartedAt:    time.Now(),
+	}
+
+	plannedMinutes := request.PlannedMinutes
+	if plannedMinutes == 0 && request.Kind == FocusSessionKindPomodoro {
+		plannedMinutes = focusSessionDefaultPomodoroMinutes
+	}
+	if plannedMinutes > 0 {
+		plannedSeconds := plannedMinutes * 60
+		session.PlannedDurationSeconds = &plannedSeconds
+	}
+
+	session, err = s.focusSessionRepository.InsertFocusSession(ctx, session)
+	if err != nil {
+		return FocusSession{}, NewInternalServiceError(err)
+	}
+
+	return session, nil
+}
+
+func (s *Service) StopFocusSession(ctx context.Context, userID uuid.UUID, sessionID int32) (FocusSession, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.StopFocusSession")
+	defer span.End()
+
+	session, err := s.focusSessionRepository.FindFocusSessionByID(ctx, sessionID)
+	if errors.Is(err, qrm.ErrNoRows) || (err == nil && session.UserID != userID) {
+		return FocusSession{}, NewClientError(http.StatusNotFound, errors.New("focus session not found"))
+	}
+	if err != nil {
+		return FocusSession{}, NewInternalServiceError(err)
+	}
+
+	if session.EndedAt != nil {
+		return FocusSession{}, NewClientError(http.StatusConflict, errors.New("focus session already stopped"))
+	}
+
+	stopped, err := s.focusSessionRepository.StopFocusSession(ctx, session.stop(time.Now()))
+	if errors.Is(err, qrm.ErrNoRows) {
+		return FocusSession{}, NewClientError(http.StatusConflict, errors.New("focus session already stopped"))
+	}
+	if err != nil {
+		return FocusSession{}, NewInternalServiceError(err)
+	}
+
+	if minutes := *stopped.DurationSeconds / 60; stopped.Completed && minutes > 0 {
+		err := s.triggerMissionEvent(ctx, userID, newMissionEvent(missionservice.TriggerMissionEvent_MISSION_EVENT_FOCUS_SESSION_COMPLETED, stopped.ID, minutes, *stopped.EndedAt))
+		if err != nil {
+			return FocusSession{}, NewInternalServiceError(err)
+		}
+	}
+
+	return stopped, nil
+}
+
+func (s *Service) GetCurrentFocusSession(ctx context.Context, userID uuid.UUID) (FocusSession, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetCurrentFocusSession")
+	defer span.End()
+
+	session, err := s.focusSessionRepository.FindRunningFocusSessionByUserID(ctx, userID)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return FocusSession{}, NewClientError(http.StatusNotFound, errors.New("no focus session is running"))
+	}
+	if err != nil {
+		return FocusSession{}, NewInternalServiceError(err)
+	}
+
+	return session, nil
+}
+
+func (s *Service) GetFocusSessions(ctx context.Context, userID uuid.UUID, assignmentID int32) ([]FocusSession, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetFocusSessions")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleViewer)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	sessions, err := s.focusSessionRepository.FindFocusSessionsByAssignmentIDAndUserID(ctx, assignment.ID, userID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return sessions, nil
+}
+
+func (s *Service) GetFocusTotals(ctx context.Context, userID uuid.UUID, group FocusTotalGroup, location *time.Location) ([]FocusTotal, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetFocusTotals")
+	defer span.End()
+
+	if _, ok := focusTotalQueries[group]; !ok {
+		return nil, NewClientError(http.StatusBadRequest, errors.New("group_by must be one of assignment, day or tag"))
+	}
+
+	if location == nil {
+		location = s.userLocation(ctx, userID)
+	}
+
+	totals, err := s.focusSessionRepository.FindFocusTotals(ctx, userID, group, location.String())
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,312 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	icalendarProductID    = "-//dev-ops-porto//assignment-service//EN"
+	icalendarDateTime     = "20060102T150405Z"
+	icalendarLineLength   = 75
+	icalendarLineBreak    = "\r\n"
+	icalendarCalendarName = "Assignments"
+)
+
+var icalendarDurationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+var icalendarTextUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\;`, ";",
+	`\,`, ",",
+	`\n`, "\n",
+	`\N`, "\n",
+)
+
+var icalendarTextEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+type icalendarWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *icalendarWriter) line(name string, value string) {
+	line := name + ":" + value
+
+	limit := icalendarLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.buf.WriteString(line[:cut])
+		w.buf.WriteString(icalendarLineBreak + " ")
+		line = line[cut:]
+		limit = icalendarLineLength - 1
+	}
+
+	w.buf.WriteString(line)
+	w.buf.WriteString(icalendarLineBreak)
+}
+
+func (w *icalendarWriter) text(name string, value string) {
+	w.line(name, icalendarTextEscaper.Replace(value))
+}
+
+func (w *icalendarWriter) dateTime(name string, value time.Time) {
+	w.line(name, value.UTC().Format(icalendarDateTime))
+}
+
+func renderCalendar(assignments []Assignment, component CalendarComponent, now time.Time) []byte {
+	w := &icalendarWriter{}
+
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", icalendarProductID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	w.text("X-WR-CALNAME", icalendarCalendarName)
+
+	for _, assignment := range assignments {
+		if assignment.DueDate == nil {
+			continue
+		}
+		writeAssignmentComponent(w, assignment, component, now)
+	}
+
+	w.line("END", "VCALENDAR")
+
+	return w.buf.Bytes()
+}
+
+func writeAssignmentComponent(w *icalendarWriter, assignment Assignment, component CalendarComponent, now time.Time) {
+	isCompleted := assignment.IsCompleted != nil && *assignment.IsCompleted
+
+	w.line("BEGIN", string(component))
+	w.line("UID", fmt.Sprintf("assignment-%d@assignment-service", assignment.ID))
+	if assignment.UpdatedAt != nil {
+		w.dateTime("DTSTAMP", *assignment.UpdatedAt)
+		w.dateTime("LAST-MODIFIED", *assignment.UpdatedAt)
+	} else {
+		w.dateTime("DTSTAMP", now)
+	}
+	if assignment.CreatedAt != nil {
+		w.dateTime("CREATED", *assignment.CreatedAt)
+	}
+	w.text("SUMMARY", assignment.Title)
+	if assignment.Note != nil && *assignment.Note != "" {
+		w.text("DESCRIPTION", *assignment.Note)
+	}
+	if assignment.IsImportant != nil && *assignment.IsImportant {
+		w.line("PRIORITY", "1")
+	}
+
+	switch component {
+	case CalendarComponentTodo:
+		w.dateTime("DUE", *assignment.DueDate)
+		if isCompleted {
+			w.line("STATUS", "COMPLETED")
+			w.line("PERCENT-COMPLETE", "100")
+		} else {
+			w.line("STATUS", "NEEDS-ACTION")
+		}
+	default:
+		w.dateTime("DTSTART", *assignment.DueDate)
+		w.line("TRANSP", "TRANSPARENT")
+		w.line("STATUS", "CONFIRMED")
+	}
+
+	if !isCompleted {
+		for _, reminder := range assignment.Reminders {
+			w.line("BEGIN", "VALARM")
+			w.line("ACTION", "DISPLAY")
+			w.text("DESCRIPTION", assignment.Title)
+			w.line("TRIGGER;VALUE=DATE-TIME", reminder.Date.UTC().Format(icalendarDateTime))
+			w.line("END", "VALARM")
+		}
+	}
+
+	w.line("END", string(component))
+}
+
+type icalendarProperty struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+func (p icalendarProperty) text() string {
+	return icalendarTextUnescaper.Replace(p.Value)
+}
+
+func (p icalendarProperty) dateTime(loc *time.Location) (time.Time, bool, error) {
+	if tzid, ok := p.Params["TZID"]; ok {
+		if tz, err := time.LoadLocation(tzid); err == nil {
+			loc = tz
+		}
+	}
+
+	value := strings.TrimSpace(p.Value)
+	switch {
+	case p.Params["VALUE"] == "DATE" || len(value) == len("20060102"):
+		t, err := time.ParseInLocation("20060102", value, loc)
+		return t, true, err
+	case strings.HasSuffix(value, "Z"):
+		t, err := time.Parse(icalendarDateTime, value)
+		return t, false, err
+	default:
+		t, err := time.ParseInLocation("20060102T150405", value, loc)
+		return t, false, err
+	}
+}
+
+type icalendarComponent struct {
+	Name       string
+	Properties []icalendarProperty
+	Components []icalendarComponent
+}
+
+func (c icalendarComponent) property(name string) (icalendarProperty, bool) {
+	for _, p := range c.Properties {
+		if p.Name == name {
+			return p, true
+		}
+	}
+
+	return icalendarProperty{}, false
+}
+
+func parseCalendar(data []byte) ([]icalendarComponent, error) {
+	lines, err := unfoldCalendarLines(data)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &icalendarComponent{}
+	stack := []*icalendarComponent{root}
+
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		property, err := parseCalendarProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar line %q: %w", line, err)
+		}
+
+		current := stack[len(stack)-1]
+		switch property.Name {
+		case "BEGIN":
+			stack = append(stack, &icalendarComponent{Name: strings.ToUpper(property.Value)})
+		case "END":
+			if len(stack) == 1 || current.Name != strings.ToUpper(property.Value) {
+				return nil, fmt.Errorf("unexpected END:%s", property.Value)
+			}
+			stack = stack[:len(stack)-1]
+			parent := stack[len(stack)-1]
+			parent.Components = append(parent.Components, *current)
+		default:
+			current.Properties = append(current.Properties, property)
+		}
+	}
+
+	if len(stack) != 1 {
+		return nil, errors.New("unterminated calendar component")
+	}
+
+	return root.Components, nil
+}
+
+func unfoldCalendarLines(data []byte) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, scanner.Err()
+}
+
+func parseCalendarProperty(line string) (icalendarProperty, error) {
+	nameAndParams, value, ok := splitCalendarProperty(line)
+	if !ok {
+		return icalendarProperty{}, errors.New("missing property value")
+	}
+
+	parts := strings.Split(nameAndParams, ";")
+	property := icalendarProperty{
+		Name:   strings.ToUpper(parts[0]),
+		Params: map[string]string{},
+		Value:  value,
+	}
+
+	for _, param := range parts[1:] {
+		key, paramValue, _ := strings.Cut(param, "=")
+		property.Params[strings.ToUpper(key)] = strings.Trim(paramValue, `"`)
+	}
+
+	return property, nil
+}
+
+func splitCalendarProperty(line string) (string, string, bool) {
+	quoted := false
+	for i, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ':' && !quoted:
+			return line[:i], line[i+1:], true
+		}
+	}
+
+	return "", "", false
+}
+
+func parseCalendarDuration(value string) (time.Duration, error) {
+	match := icalendarDurationPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil || strings.Join(match[2:], "") == "" {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	var duration time.Duration
+	for i, unit := range units {
+		if match[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match[i+2])
+		if err != nil {
+			return 0, err
+		}
+		duration += time.Duration(n) * unit
+	}
+
+	if match[1] == "-" {
+		duration = -duration
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,107 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestICalendarTextEscaping(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "plain", value: "Physics essay", want: "Physics essay"},
+		{name: "comma", value: "Read chapters 1, 2", want: `Read chapters 1\, 2`},
+		{name: "semicolon", value: "Lab; bring goggles", want: `Lab\; bring goggles`},
+		{name: "backslash", value: `C:\notes`, want: `C:\\notes`},
+		{name: "escaped sequence stays literal", value: `a\,b`, want: `a\\\,b`},
+		{name: "line feed", value: "line one\nline two", want: `line one\nline two`},
+		{name: "carriage return line feed", value: "line one\r\nline two", want: `line one\nline two`},
+		{name: "carriage return", value: "line one\rline two", want: `line one\nline two`},
+		{name: "colon is not escaped", value: "Due: 5pm", want: "Due: 5pm"},
+		{name: "multi-byte", value: "Résumé, 日本語", want: `Résumé\, 日本語`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &icalendarWriter{}
+			w.text("SUMMARY", tt.value)
+
+			want := "SUMMARY:" + tt.want + icalendarLineBreak
+			if got := w.buf.String(); got != want {
+				t.Fatalf("text() = %q, want %q", got, want)
+			}
+
+			lines, err := unfoldCalendarLines(w.buf.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			property, err := parseCalendarProperty(lines[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			roundTrip := strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(tt.value)
+			if got := property.text(); got != roundTrip {
+				t.Errorf("round trip = %q, want %q", got, roundTrip)
+			}
+		})
+	}
+}
+
+func TestICalendarLineFolding(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		lines int
+	}{
+		{name: "short", value: "Essay", lines: 1},
+		{name: "exactly one line", value: strings.Repeat("a", icalendarLineLength-len("SUMMARY:")), lines: 1},
+		{name: "one octet over", value: strings.Repeat("a", icalendarLineLength-len("SUMMARY:")+1), lines: 2},
+		{name: "continuation lines hold 74 octets", value: strings.Repeat("a", icalendarLineLength-len("SUMMARY:")+74), lines: 2},
+		{name: "long ascii", value: strings.Repeat("abcdefghij", 30), lines: 5},
+		{name: "two-byte runes", value: strings.Repeat("é", 100), lines: 3},
+		{name: "three-byte runes across the boundary", value: "ab" + strings.Repeat("日本語", 30), lines: 4},
+		{name: "four-byte runes across the boundary", value: "a" + strings.Repeat("📚", 40), lines: 3},
+		{name: "mixed", value: strings.Repeat("Résumé 日本語 📚 ", 12), lines: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &icalendarWriter{}
+			w.line("SUMMARY", tt.value)
+
+			output := w.buf.String()
+			if !strings.HasSuffix(output, icalendarLineBreak) {
+				t.Fatalf("output %q does not end with CRLF", output)
+			}
+
+			physical := strings.Split(strings.TrimSuffix(output, icalendarLineBreak), icalendarLineBreak)
+			if len(physical) != tt.lines {
+				t.Errorf("folded into %d lines, want %d", len(physical), tt.lines)
+			}
+
+			for i, line := range physical {
+				if len(line) > icalendarLineLength {
+					t.Errorf("line %d is %d octets, want at most %d", i, len(line), icalendarLineLength)
+				}
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Errorf("continuation line %d does not start with a space: %q", i, line)
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("line %d splits a UTF-8 sequence: %q", i, line)
+				}
+			}
+
+			lines, err := unfoldCalendarLines(w.buf.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(lines) != 1 || lines[0] != "SUMMARY:"+tt.value {
+				t.Errorf("unfolded = %q, want %q", lines, "SUMMARY:"+tt.value)
+			}
+		})
+	}
+}
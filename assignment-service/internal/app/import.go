@@ -0,0 +1,107 @@
+package app
+
+import (
+	"time"
+)
+
+type ImportFormat string
+
+const (
+	ImportFormatICS  ImportFormat = "ics"
+	ImportFormatJSON ImportFormat = "json"
+	ImportFormatCSV  ImportFormat = "csv"
+)
+
+type ImportRowStatus string
+
+const (
+	ImportRowStatusReady     ImportRowStatus = "ready"
+	ImportRowStatusCreated   ImportRowStatus = "created"
+	ImportRowStatusDuplicate ImportRowStatus = "duplicate"
+	ImportRowStatusError     ImportRowStatus = "error"
+)
+
+type ImportFieldMapping struct {
+	Title       string `json:"title"`
+	Note        string `json:"note"`
+	DueDate     string `json:"due_date"`
+	IsImportant string `json:"is_important"`
+	IsCompleted string `json:"is_completed"`
+	Reminders   string `json:"reminders"`
+}
+
+func (m ImportFieldMapping) withDefaults(defaults ImportFieldMapping) ImportFieldMapping {
+	if m.Title == "" {
+		m.Title = defaults.Title
+	}
+	if m.Note == "" {
+		m.Note = defaults.Note
+	}
+	if m.DueDate == "" {
+		m.DueDate = defaults.DueDate
+	}
+	if m.IsImportant == "" {
+		m.IsImportant = defaults.IsImportant
+	}
+	if m.IsCompleted == "" {
+		m.IsCompleted = defaults.IsCompleted
+	}
+	if m.Reminders == "" {
+		m.Reminders = defaults.Reminders
+	}
+	return m
+}
+
+var (
+	defaultCSVImportFieldMapping = ImportFieldMapping{
+		Title:       "CONTENT",
+		Note:        "DESCRIPTION",
+		DueDate:     "DATE",
+		IsImportant: "PRIORITY",
+	}
+	defaultJSONImportFieldMapping = ImportFieldMapping{
+		Title:       "title",
+		Note:        "note",
+		DueDate:     "due_date",
+		IsImportant: "is_important",
+		IsCompleted: "is_completed",
+		Reminders:   "reminders",
+	}
+)
+
+type ImportRequest struct {
+	Format   ImportFormat
+	FileName string
+	File     []byte
+	Mapping  ImportFieldMapping
+	Location *time.Location
+	DryRun   bool
+}
+
+type ImportRowResult struct {
+	Row        int             `json:"row"`
+	Status     ImportRowStatus `json:"status"`
+	Error      string          `json:"error,omitempty"`
+	Assignment *Assignment     `json:"assignment,omitempty"`
+}
+
+type ImportResult struct {
+	DryRun     bool              `json:"dry_run"`
+	Total      int               `json:"total"`
+	Ready      int               `json:"ready"`
+	Created    int               `json:"created"`
+	Duplicates int               `json:"duplicates"`
+	Errors     int               `json:"errors"`
+	Rows       []ImportRowResult `json:"rows"`
+}
+
+type importRow struct {
+	row         int
+	title       string
+	note        string
+	dueDate     *time.Time
+	isImportant bool
+	isCompleted bool
+	reminders   []time.Time
+	err         error
+}
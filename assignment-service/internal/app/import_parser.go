@@ -0,0 +1,429 @@
+package app
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	importTitleMaxLength = 255
+	importMaxRows        = 1000
+)
+
+var errImportTooManyRows = fmt.Errorf("import is limited to %d rows", importMaxRows)
+
+var importDateTimeLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"20060102T150405",
+}
+
+var importDateLayouts = []string{
+	"2006-01-02",
+	"20060102",
+}
+
+func parseImport(format ImportFormat, data []byte, mapping ImportFieldMapping, loc *time.Location) ([]importRow, error) {
+	var rows []importRow
+	var err error
+	switch format {
+	case ImportFormatICS:
+		rows, err = parseICSImport(data, loc)
+	case ImportFormatCSV:
+		rows, err = parseCSVImport(data, mapping, loc)
+	case ImportFormatJSON:
+		rows, err = parseJSONImport(data, mapping, loc)
+	default:
+		err = fmt.Errorf("unsupported import format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) > importMaxRows {
+		return nil, errImportTooManyRows
+	}
+
+	return rows, nil
+}
+
+func parseICSImport(data []byte, loc *time.Location) ([]importRow, error) {
+	components, err := parseCalendar(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []importRow
+	hasCalendar := false
+	for _, calendar := range components {
+		if calendar.Name != "VCALENDAR" {
+			continue
+		}
+		hasCalendar = true
+
+		for _, component := range calendar.Components {
+			if component.Name != string(CalendarComponentEvent) && component.Name != string(CalendarComponentTodo) {
+				continue
+			}
+			rows = append(rows, newICSImportRow(len(rows)+1, component, loc))
+		}
+	}
+
+	if !hasCalendar {
+		return nil, errors.New("no VCALENDAR found")
+	}
+
+	return rows, nil
+}
+
+func newICSImportRow(row int, component icalendarComponent, loc *time.Location) importRow {
+	r := importRow{row: row}
+
+	if summary, ok := component.property("SUMMARY"); ok {
+		r.title = summary.text()
+	}
+	if description, ok := component.property("DESCRIPTION"); ok {
+		r.note = description.text()
+	}
+
+	var start, end *time.Time
+	if dtstart, ok := component.property("DTSTART"); ok {
+		t, isDate, err := dtstart.dateTime(loc)
+		if err != nil {
+			return r.withError(fmt.Errorf("invalid DTSTART: %w", err))
+		}
+		if isDate && component.Name == string(CalendarComponentEvent) {
+			t = endOfImportDay(t)
+		}
+		start = &t
+	}
+
+	endProperty := "DTEND"
+	if component.Name == string(CalendarComponentTodo) {
+		endProperty = "DUE"
+	}
+	if dtend, ok := component.property(endProperty); ok {
+		t, isDate, err := dtend.dateTime(loc)
+		if err != nil {
+			return r.withError(fmt.Errorf("invalid %s: %w", endProperty, err))
+		}
+		if isDate {
+			t = endOfImportDay(t)
+		}
+		end = &t
+	}
+
+	if component.Name == string(CalendarComponentTodo) {
+		r.dueDate = end
+		if r.dueDate == nil {
+			r.dueDate = start
+		}
+	} else {
+		r.dueDate = start
+	}
+
+	if priority, ok := component.property("PRIORITY"); ok {
+		p, err := strconv.Atoi(strings.TrimSpace(priority.Value))
+		r.isImportant = err == nil && p >= 1 && p <= 4
+	}
+
+	_, hasCompleted := component.property("COMPLETED")
+	status, _ := component.property("STATUS")
+	r.isCompleted = hasCompleted || strings.EqualFold(status.Value, "COMPLETED")
+
+	for _, alarm := range component.Components {
+		if alarm.Name != "VALARM" {
+			continue
+		}
+
+		trigger, ok := alarm.property("TRIGGER")
+		if !ok {
+			continue
+		}
+
+		if trigger.Params["VALUE"] == "DATE-TIME" {
+			t, _, err := trigger.dateTime(loc)
+			if err != nil {
+				return r.withError(fmt.Errorf("invalid alarm trigger: %w", err))
+			}
+			r.reminders = append(r.reminders, t)
+			continue
+		}
+
+		offset, err := parseCalendarDuration(trigger.Value)
+		if err != nil {
+			return r.withError(fmt.Errorf("invalid alarm trigger: %w", err))
+		}
+
+		related := start
+		if trigger.Params["RELATED"] == "END" || related == nil {
+			related = end
+		}
+		if related == nil {
+			related = r.dueDate
+		}
+		if related == nil {
+			return r.withError(errors.New("relative alarm without a start or due date"))
+		}
+		r.reminders = append(r.reminders, related.Add(offset))
+	}
+
+	return r.validate()
+}
+
+func parseCSVImport(data []byte, mapping ImportFieldMapping, loc *time.Location) ([]importRow, error) {
+	mapping = mapping.withDefaults(defaultCSVImportFieldMapping)
+
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	if _, ok := columns[strings.ToLower(mapping.Title)]; !ok {
+		return nil, fmt.Errorf("title column %q not found", mapping.Title)
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[strings.ToLower(name)]
+		if name == "" || !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+
+		if strings.Join(record, "") == "" {
+			continue
+		}
+
+		// Todoist exports sections and comments alongside tasks.
+		if recordType := field(record, "TYPE"); recordType != "" && !strings.EqualFold(recordType, "task") {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		var reminders []string
+		if value := field(record, mapping.Reminders); value != "" {
+			reminders = strings.Split(value, ";")
+		}
+
+		rows = append(rows, newImportRow(
+			line,
+			field(record, mapping.Title),
+			field(record, mapping.Note),
+			field(record, mapping.DueDate),
+			field(record, mapping.IsImportant),
+			field(record, mapping.IsCompleted),
+			reminders,
+			loc,
+		))
+	}
+
+	return rows, nil
+}
+
+func parseJSONImport(data []byte, mapping ImportFieldMapping, loc *time.Location) ([]importRow, error) {
+	mapping = mapping.withDefaults(defaultJSONImportFieldMapping)
+
+	var document any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	items, ok := document.([]any)
+	if object, isObject := document.(map[string]any); isObject {
+		for _, key := range []string{"items", "tasks", "assignments"} {
+			if items, ok = object[key].([]any); ok {
+				break
+			}
+		}
+	}
+	if !ok {
+		return nil, errors.New("expected a JSON array or an object with an items, tasks or assignments array")
+	}
+
+	rows := make([]importRow, 0, len(items))
+	for i, item := range items {
+		object, ok := item.(map[string]any)
+		if !ok {
+			rows = append(rows, importRow{row: i + 1, err: errors.New("item is not an object")})
+			continue
+		}
+
+		var reminders []string
+		switch value := lookupJSONImportField(object, mapping.Reminders).(type) {
+		case []any:
+			for _, reminder := range value {
+				reminders = append(reminders, jsonImportString(reminder))
+			}
+		case nil:
+		default:
+			reminders = append(reminders, jsonImportString(value))
+		}
+
+		rows = append(rows, newImportRow(
+			i+1,
+			jsonImportString(lookupJSONImportField(object, mapping.Title)),
+			jsonImportString(lookupJSONImportField(object, mapping.Note)),
+			jsonImportString(lookupJSONImportField(object, mapping.DueDate)),
+			jsonImportString(lookupJSONImportField(object, mapping.IsImportant)),
+			jsonImportString(lookupJSONImportField(object, mapping.IsCompleted)),
+			reminders,
+			loc,
+		))
+	}
+
+	return rows, nil
+}
+
+func lookupJSONImportField(object map[string]any, path string) any {
+	if path == "" {
+		return nil
+	}
+
+	var value any = object
+	for _, key := range strings.Split(path, ".") {
+		current, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = current[key]
+	}
+
+	return value
+}
+
+func jsonImportString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return ""
+	}
+}
+
+func newImportRow(row int, title, note, dueDate, isImportant, isCompleted string, reminders []string, loc *time.Location) importRow {
+	r := importRow{
+		row:         row,
+		title:       title,
+		note:        note,
+		isImportant: parseImportBool(isImportant),
+		isCompleted: parseImportBool(isCompleted),
+	}
+
+	if dueDate != "" {
+		t, err := parseImportTime(dueDate, loc, true)
+		if err != nil {
+			return r.withError(fmt.Errorf("invalid due date: %w", err))
+		}
+		r.dueDate = &t
+	}
+
+	for _, reminder := range reminders {
+		reminder = strings.TrimSpace(reminder)
+		if reminder == "" {
+			continue
+		}
+		t, err := parseImportTime(reminder, loc, false)
+		if err != nil {
+			return r.withError(fmt.Errorf("invalid reminder: %w", err))
+		}
+		r.reminders = append(r.reminders, t)
+	}
+
+	return r.validate()
+}
+
+func (r importRow) validate() importRow {
+	r.title = strings.TrimSpace(r.title)
+
+	switch {
+	case r.title == "":
+		return r.withError(errors.New("missing title"))
+	case len(r.title) > importTitleMaxLength:
+		return r.withError(fmt.Errorf("title is longer than %d characters", importTitleMaxLength))
+	case r.dueDate == nil:
+		return r.withError(errors.New("missing due date"))
+	}
+
+	return r
+}
+
+func (r importRow) withError(err error) importRow {
+	r.err = err
+	return r
+}
+
+func parseImportTime(value string, loc *time.Location, dateEndOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(icalendarDateTime, value); err == nil {
+		return t, nil
+	}
+
+	for _, layout := range importDateTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	for _, layout := range importDateLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			if dateEndOfDay {
+				t = endOfImportDay(t)
+			}
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized date %q", value)
+}
+
+func endOfImportDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 0, 0, t.Location())
+}
+
+func parseImportBool(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "yes", "y", "1", "p1", "high":
+		return true
+	default:
+		return false
+	}
+}
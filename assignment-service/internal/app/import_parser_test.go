@@ -0,0 +1,617 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type importRowWant struct {
+	row       int
+	title     string
+	note      string
+	dueDate   time.Time
+	important bool
+	completed bool
+	reminders []time.Time
+	err       string
+}
+
+func loadImportTestLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return loc
+}
+
+func checkImportRows(t *testing.T, got []importRow, want []importRowWant) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d rows, want %d: %+v", len(got), len(want), got)
+	}
+
+	for i, w := range want {
+		g := got[i]
+
+		if g.row != w.row {
+			t.Errorf("row %d: row number = %d, want %d", i, g.row, w.row)
+		}
+
+		if w.err != "" {
+			if g.err == nil || !strings.Contains(g.err.Error(), w.err) {
+				t.Errorf("row %d: error = %v, want %q", w.row, g.err, w.err)
+			}
+			continue
+		}
+		if g.err != nil {
+			t.Errorf("row %d: unexpected error %v", w.row, g.err)
+			continue
+		}
+
+		if g.title != w.title {
+			t.Errorf("row %d: title = %q, want %q", w.row, g.title, w.title)
+		}
+		if g.note != w.note {
+			t.Errorf("row %d: note = %q, want %q", w.row, g.note, w.note)
+		}
+		if g.dueDate == nil || !g.dueDate.Equal(w.dueDate) {
+			t.Errorf("row %d: due date = %v, want %v", w.row, g.dueDate, w.dueDate)
+		}
+		if g.isImportant != w.important {
+			t.Errorf("row %d: important = %v, want %v", w.row, g.isImportant, w.important)
+		}
+		if g.isCompleted != w.completed {
+			t.Errorf("row %d: completed = %v, want %v", w.row, g.isCompleted, w.completed)
+		}
+		if !slices.EqualFunc(g.reminders, w.reminders, time.Time.Equal) {
+			t.Errorf("row %d: reminders = %v, want %v", w.row, g.reminders, w.reminders)
+		}
+	}
+}
+
+func TestParseCSVImport(t *testing.T) {
+	jakarta := loadImportTestLocation(t, "Asia/Jakarta")
+
+	todoist := []string{
+		"TYPE,CONTENT,DESCRIPTION,PRIORITY,INDENT,AUTHOR,RESPONSIBLE,DATE,DATE_LANG,TIMEZONE",
+		"section,Week 1,,,,,,,,",
+		"task,Physics essay,Chapter 3,p1,1,Ann,,2026-03-10,en,Asia/Jakarta",
+		"task,Read notes,,p4,1,Ann,,2026-03-11 09:30,en,Asia/Jakarta",
+		"note,Remember the goggles,,,,,,,,",
+		`task,"Lab, report","first line`,
+		`second line",p2,1,Ann,,2026-03-12T10:00:00Z,en,`,
+		"task,Broken date,,p1,1,Ann,,every other tuesday,en,",
+		"task,,,p1,1,Ann,,2026-03-12,en,",
+		"task,No date,,p1,1,Ann,,,en,",
+	}
+	todoistRows := []importRowWant{
+		{
+			row:       3,
+			title:     "Physics essay",
+			note:      "Chapter 3",
+			dueDate:   time.Date(2026, time.March, 10, 23, 59, 0, 0, jakarta),
+			important: true,
+		},
+		{
+			row:     4,
+			title:   "Read notes",
+			dueDate: time.Date(2026, time.March, 11, 9, 30, 0, 0, jakarta),
+		},
+		{
+			row:     6,
+			title:   "Lab, report",
+			note:    "first line\nsecond line",
+			dueDate: time.Date(2026, time.March, 12, 10, 0, 0, 0, time.UTC),
+		},
+		{row: 8, err: "invalid due date"},
+		{row: 9, err: "missing title"},
+		{row: 10, err: "missing due date"},
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		mapping ImportFieldMapping
+		rows    []importRowWant
+		err     string
+	}{
+		{
+			name: "todoist export",
+			data: strings.Join(todoist, "\n") + "\n",
+			rows: todoistRows,
+		},
+		{
+			name: "todoist export with bom and crlf",
+			data: "\xef\xbb\xbf" + strings.Join(todoist, "\r\n") + "\r\n",
+			rows: todoistRows,
+		},
+		{
+			name: "custom mapping",
+			data: "Name,Due,Done,Alerts\n" +
+				"Essay,2026-03-10 17:00,yes,2026-03-10 09:00;2026-03-10 12:00\n" +
+				"\n" +
+				"Lab,20260311,no,\n",
+			mapping: ImportFieldMapping{Title: "name", DueDate: "due", IsCompleted: "done", Reminders: "alerts"},
+			rows: []importRowWant{
+				{
+					row:       2,
+					title:     "Essay",
+					dueDate:   time.Date(2026, time.March, 10, 17, 0, 0, 0, jakarta),
+					completed: true,
+					reminders: []time.Time{
+						time.Date(2026, time.March, 10, 9, 0, 0, 0, jakarta),
+						time.Date(2026, time.March, 10, 12, 0, 0, 0, jakarta),
+					},
+				},
+				{
+					row:     4,
+					title:   "Lab",
+					dueDate: time.Date(2026, time.March, 11, 23, 59, 0, 0, jakarta),
+				},
+			},
+		},
+		{
+			name: "malformed reminder",
+			data: "CONTENT,DATE,REMINDERS\nEssay,2026-03-10,tomorrow\n",
+			mapping: ImportFieldMapping{
+				Reminders: "REMINDERS",
+			},
+			rows: []importRowWant{{row: 2, err: "invalid reminder"}},
+		},
+		{
+			name: "malformed calendar date",
+			data: "CONTENT,DATE\nEssay,2026-02-30\n",
+			rows: []importRowWant{{row: 2, err: "invalid due date"}},
+		},
+		{
+			name: "title too long",
+			data: "CONTENT,DATE\n" + strings.Repeat("a", importTitleMaxLength+1) + ",2026-03-10\n",
+			rows: []importRowWant{{row: 2, err: "title is longer than"}},
+		},
+		{
+			name: "missing title column",
+			data: "Name,Due\nEssay,2026-03-10\n",
+			err:  `title column "CONTENT" not found`,
+		},
+		{
+			name: "empty file",
+			data: "",
+			err:  "failed to read CSV header",
+		},
+		{
+			name: "header only",
+			data: "\xef\xbb\xbfCONTENT,DATE\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := parseCSVImport([]byte(tt.data), tt.mapping, jakarta)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			checkImportRows(t, rows, tt.rows)
+		})
+	}
+}
+
+func TestParseJSONImport(t *testing.T) {
+	jakarta := loadImportTestLocation(t, "Asia/Jakarta")
+
+	tests := []struct {
+		name    string
+		data    string
+		mapping ImportFieldMapping
+		rows    []importRowWant
+		err     string
+	}{
+		{
+			name: "array with default fields",
+			data: `[
+				{"title": " Essay ", "note": "Ch 3", "due_date": "2026-03-10T17:00:00+07:00", "is_important": true, "is_completed": "yes", "reminders": ["2026-03-10 09:00", ""]},
+				"not an object",
+				{"title": "Bad", "due_date": "31/02/2026"},
+				{"title": "Numbers", "due_date": "20260310", "is_important": 1, "reminders": "2026-03-09"},
+				{"title": "Bad reminder", "due_date": "2026-03-10", "reminders": ["soon"]}
+			]`,
+			rows: []importRowWant{
+				{
+					row:       1,
+					title:     "Essay",
+					note:      "Ch 3",
+					dueDate:   time.Date(2026, time.March, 10, 10, 0, 0, 0, time.UTC),
+					important: true,
+					completed: true,
+					reminders: []time.Time{time.Date(2026, time.March, 10, 9, 0, 0, 0, jakarta)},
+				},
+				{row: 2, err: "item is not an object"},
+				{row: 3, err: "invalid due date"},
+				{
+					row:       4,
+					title:     "Numbers",
+					dueDate:   time.Date(2026, time.March, 10, 23, 59, 0, 0, jakarta),
+					important: true,
+					reminders: []time.Time{time.Date(2026, time.March, 9, 0, 0, 0, 0, jakarta)},
+				},
+				{row: 5, err: "invalid reminder"},
+			},
+		},
+		{
+			name: "object with tasks and nested mapping",
+			data: `{"tasks": [{"fields": {"name": "Lab", "due": "2026-03-11T08:00:00Z"}, "done": false}]}`,
+			mapping: ImportFieldMapping{
+				Title:       "fields.name",
+				DueDate:     "fields.due",
+				IsCompleted: "done",
+			},
+			rows: []importRowWant{
+				{row: 1, title: "Lab", dueDate: time.Date(2026, time.March, 11, 8, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "nested path through a scalar",
+			data: `{"items": [{"fields": "Lab", "due_date": "2026-03-11"}]}`,
+			mapping: ImportFieldMapping{
+				Title: "fields.name",
+			},
+			rows: []importRowWant{{row: 1, err: "missing title"}},
+		},
+		{
+			name: "missing due date",
+			data: `{"assignments": [{"title": "Lab"}]}`,
+			rows: []importRowWant{{row: 1, err: "missing due date"}},
+		},
+		{
+			name: "empty array",
+			data: `[]`,
+		},
+		{
+			name: "object without items",
+			data: `{"title": "Lab"}`,
+			err:  "expected a JSON array",
+		},
+		{
+			name: "scalar document",
+			data: `"Lab"`,
+			err:  "expected a JSON array",
+		},
+		{
+			name: "malformed document",
+			data: `[{"title": "Lab",}]`,
+			err:  "invalid JSON",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := parseJSONImport([]byte(tt.data), tt.mapping, jakarta)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			checkImportRows(t, rows, tt.rows)
+		})
+	}
+}
+
+func TestParseICSImport(t *testing.T) {
+	jakarta := loadImportTestLocation(t, "Asia/Jakarta")
+	newYork := loadImportTestLocation(t, "America/New_York")
+
+	calendar := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		`SUMMARY:Physics essay\, dr`,
+		" aft",
+		`DESCRIPTION:Line one\nLine two`,
+		"DTSTART;VALUE=DATE:20260310",
+		"PRIORITY:1",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"TRIGGER:-PT1H",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VTODO",
+		"SUMMARY:Lab report",
+		"DTSTART:20260301T090000Z",
+		"DUE;TZID=America/New_York:20260312T170000",
+		"STATUS:COMPLETED",
+		"PRIORITY:9",
+		"BEGIN:VALARM",
+		"TRIGGER;RELATED=END:-P1D",
+		"END:VALARM",
+		"BEGIN:VALARM",
+		"TRIGGER;VALUE=DATE-TIME:20260312T120000Z",
+		"END:VALARM",
+		"END:VTODO",
+		"BEGIN:VJOURNAL",
+		"SUMMARY:Ignored",
+		"END:VJOURNAL",
+		"BEGIN:VEVENT",
+		"SUMMARY:Broken",
+		"DTSTART:2026-03-10",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Bad alarm",
+		"DTSTART:20260310T080000",
+		"BEGIN:VALARM",
+		"TRIGGER:soon",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:No date",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n") + "\r\n"
+
+	tests := []struct {
+		name string
+		data string
+		rows []importRowWant
+		err  string
+	}{
+		{
+			name: "events and todos",
+			data: calendar,
+			rows: []importRowWant{
+				{
+					row:       1,
+					title:     "Physics essay, draft",
+					note:      "Line one\nLine two",
+					dueDate:   time.Date(2026, time.March, 10, 23, 59, 0, 0, jakarta),
+					important: true,
+					reminders: []time.Time{time.Date(2026, time.March, 10, 22, 59, 0, 0, jakarta)},
+				},
+				{
+					row:       2,
+					title:     "Lab report",
+					dueDate:   time.Date(2026, time.March, 12, 17, 0, 0, 0, newYork),
+					completed: true,
+					reminders: []time.Time{
+						time.Date(2026, time.March, 11, 17, 0, 0, 0, newYork),
+						time.Date(2026, time.March, 12, 12, 0, 0, 0, time.UTC),
+					},
+				},
+				{row: 3, err: "invalid DTSTART"},
+				{row: 4, err: "invalid alarm trigger"},
+				{row: 5, err: "missing due date"},
+			},
+		},
+		{
+			name: "lf line endings",
+			data: "BEGIN:VCALENDAR\nBEGIN:VTODO\nSUMMARY:Lab\nDUE:20260312T170000Z\nEND:VTODO\nEND:VCALENDAR\n",
+			rows: []importRowWant{
+				{row: 1, title: "Lab", dueDate: time.Date(2026, time.March, 12, 17, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "no calendar",
+			data: "BEGIN:VEVENT\r\nSUMMARY:Lab\r\nEND:VEVENT\r\n",
+			err:  "no VCALENDAR found",
+		},
+		{
+			name: "unterminated calendar",
+			data: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nSUMMARY:Lab\r\n",
+			err:  "unterminated calendar component",
+		},
+		{
+			name: "mismatched end",
+			data: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VTODO\r\nEND:VCALENDAR\r\n",
+			err:  "unexpected END:VTODO",
+		},
+		{
+			name: "line without value",
+			data: "BEGIN:VCALENDAR\r\nSUMMARY\r\nEND:VCALENDAR\r\n",
+			err:  "missing property value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := parseICSImport([]byte(tt.data), jakarta)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			checkImportRows(t, rows, tt.rows)
+		})
+	}
+}
+
+func TestParseImportRowLimit(t *testing.T) {
+	csvFile := func(rows int) []byte {
+		var b strings.Builder
+		b.WriteString("CONTENT,DATE\n")
+		for i := range rows {
+			fmt.Fprintf(&b, "Task %d,2026-03-10\n", i)
+		}
+		return []byte(b.String())
+	}
+	jsonFile := func(rows int) []byte {
+		items := make([]string, rows)
+		for i := range items {
+			items[i] = fmt.Sprintf(`{"title": "Task %d", "due_date": "2026-03-10"}`, i)
+		}
+		return []byte("[" + strings.Join(items, ",") + "]")
+	}
+	icsFile := func(rows int) []byte {
+		var b strings.Builder
+		b.WriteString("BEGIN:VCALENDAR\r\n")
+		for i := range rows {
+			fmt.Fprintf(&b, "BEGIN:VTODO\r\nSUMMARY:Task %d\r\nDUE:20260310T170000Z\r\nEND:VTODO\r\n", i)
+		}
+		b.WriteString("END:VCALENDAR\r\n")
+		return []byte(b.String())
+	}
+
+	tests := []struct {
+		name   string
+		format ImportFormat
+		data   []byte
+		err    error
+	}{
+		{name: "csv at the limit", format: ImportFormatCSV, data: csvFile(importMaxRows)},
+		{name: "csv over the limit", format: ImportFormatCSV, data: csvFile(importMaxRows + 1), err: errImportTooManyRows},
+		{name: "json at the limit", format: ImportFormatJSON, data: jsonFile(importMaxRows)},
+		{name: "json over the limit", format: ImportFormatJSON, data: jsonFile(importMaxRows + 1), err: errImportTooManyRows},
+		{name: "ics at the limit", format: ImportFormatICS, data: icsFile(importMaxRows)},
+		{name: "ics over the limit", format: ImportFormatICS, data: icsFile(importMaxRows + 1), err: errImportTooManyRows},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := parseImport(tt.format, tt.data, ImportFieldMapping{}, time.UTC)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("error = %v, want %v", err, tt.err)
+			}
+			if tt.err == nil && len(rows) != importMaxRows {
+				t.Errorf("got %d rows, want %d", len(rows), importMaxRows)
+			}
+		})
+	}
+
+	if _, err := parseImport("xlsx", nil, ImportFieldMapping{}, time.UTC); err == nil || errors.Is(err, errImportTooManyRows) {
+		t.Errorf("unsupported format error = %v", err)
+	}
+}
+
+func TestParseImportTime(t *testing.T) {
+	jakarta := loadImportTestLocation(t, "Asia/Jakarta")
+
+	tests := []struct {
+		value        string
+		dateEndOfDay bool
+		want         time.Time
+		err          bool
+	}{
+		{value: "2026-03-10T17:00:00+02:00", want: time.Date(2026, time.March, 10, 15, 0, 0, 0, time.UTC)},
+		{value: "20260310T170000Z", want: time.Date(2026, time.March, 10, 17, 0, 0, 0, time.UTC)},
+		{value: "2026-03-10T17:00:00", want: time.Date(2026, time.March, 10, 17, 0, 0, 0, jakarta)},
+		{value: "2026-03-10T17:00", want: time.Date(2026, time.March, 10, 17, 0, 0, 0, jakarta)},
+		{value: "2026-03-10 17:00:30", want: time.Date(2026, time.March, 10, 17, 0, 30, 0, jakarta)},
+		{value: "2026-03-10 17:00", want: time.Date(2026, time.March, 10, 17, 0, 0, 0, jakarta)},
+		{value: "20260310T170000", want: time.Date(2026, time.March, 10, 17, 0, 0, 0, jakarta)},
+		{value: "2026-03-10", want: time.Date(2026, time.March, 10, 0, 0, 0, 0, jakarta)},
+		{value: "2026-03-10", dateEndOfDay: true, want: time.Date(2026, time.March, 10, 23, 59, 0, 0, jakarta)},
+		{value: "20260310", dateEndOfDay: true, want: time.Date(2026, time.March, 10, 23, 59, 0, 0, jakarta)},
+		{value: "2026-13-01", err: true},
+		{value: "2026-02-30", err: true},
+		{value: "10/03/2026", err: true},
+		{value: "tomorrow", err: true},
+		{value: "", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseImportTime(tt.value, jakarta, tt.dateEndOfDay)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("parseImportTime(%q) = %v, want an error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseImportTime(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanImport(t *testing.T) {
+	userID := uuid.MustParse("0b7f1a52-6d1e-4c55-9b8e-2f0f4f7c1a11")
+	now := time.Date(2026, time.March, 7, 10, 0, 0, 0, time.UTC)
+	at := func(hour, min, sec int) *time.Time {
+		t := time.Date(2026, time.March, 10, hour, min, sec, 0, time.UTC)
+		return &t
+	}
+
+	existing := []Assignment{
+		{Title: "Physics Essay", DueDate: at(16, 59, 30)},
+		{Title: "Undated", DueDate: nil},
+	}
+
+	rows := []importRow{
+		// Same title in another case, same minute as the existing assignment.
+		{row: 1, title: "physics essay", dueDate: at(16, 59, 0)},
+		// Same title, different due date.
+		{row: 2, title: "Physics essay", dueDate: at(17, 0, 0)},
+		// Duplicate of row 2 within the same file.
+		{row: 3, title: "  PHYSICS ESSAY ", dueDate: at(17, 0, 45)},
+		{row: 4, err: errors.New("invalid due date")},
+		// Past reminders are dropped.
+		{row: 5, title: "Lab", dueDate: at(9, 0, 0), reminders: []time.Time{now.Add(-time.Hour), now.Add(time.Hour)}},
+		// Same title as an existing assignment without a due date.
+		{row: 6, title: "Undated", dueDate: at(9, 0, 0)},
+	}
+
+	result, pending := planImport(rows, existing, userID, now)
+
+	wantStatuses := []ImportRowStatus{
+		ImportRowStatusDuplicate,
+		ImportRowStatusReady,
+		ImportRowStatusDuplicate,
+		ImportRowStatusError,
+		ImportRowStatusReady,
+		ImportRowStatusReady,
+	}
+	for i, want := range wantStatuses {
+		if got := result.Rows[i].Status; got != want {
+			t.Errorf("row %d: status = %q, want %q", rows[i].row, got, want)
+		}
+		if got := result.Rows[i].Row; got != rows[i].row {
+			t.Errorf("row %d: row number = %d", rows[i].row, got)
+		}
+	}
+
+	if !slices.Equal(pending, []int{1, 4, 5}) {
+		t.Errorf("pending = %v, want [1 4 5]", pending)
+	}
+	if result.Total != 6 || result.Ready != 3 || result.Duplicates != 2 || result.Errors != 1 {
+		t.Errorf("totals = %d/%d/%d/%d, want 6/3/2/1", result.Total, result.Ready, result.Duplicates, result.Errors)
+	}
+	if result.Rows[3].Error != "invalid due date" || result.Rows[3].Assignment != nil {
+		t.Errorf("error row = %+v", result.Rows[3])
+	}
+
+	lab := result.Rows[4].Assignment
+	if lab.UserID != userID {
+		t.Errorf("user id = %v, want %v", lab.UserID, userID)
+	}
+	if len(lab.Reminders) != 1 || !lab.Reminders[0].Date.Equal(now.Add(time.Hour)) {
+		t.Errorf("reminders = %+v, want only the future reminder", lab.Reminders)
+	}
+}
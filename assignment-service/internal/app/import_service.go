@@ -0,0 +1,167 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) ImportAssignments(ctx context.Context, userID uuid.UUID, request ImportRequest) (ImportResult, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.ImportAssignments")
+	defer span.End()
+
+	format := request.Format
+	if format == "" {
+		format = ImportFormat(strings.TrimPrefix(strings.ToLower(filepath.Ext(request.FileName)), "."))
+	}
+
+	loc := request.Location
+	if loc == nil {
+		loc = s.userLocation(ctx, userID)
+	}
+
+	_, parsingSpan := s.tracer.Start(ctx, "parsing import file")
+	rows, err := parseImport(format, request.File, request.Mapping, loc)
+	parsingSpan.End()
+	if errors.Is(err, errImportTooManyRows) {
+		return ImportResult{}, NewClientError(http.StatusRequestEntityTooLarge, err)
+	}
+	if err != nil {
+		return ImportResult{}, NewClientError(http.StatusBadRequest, err)
+	}
+
+	existing, err := s.assignmentRepository.FindAssignmentsByUserIDJoinReminders(ctx, userID)
+	if err != nil {
+		return ImportResult{}, NewInternalServiceError(err)
+	}
+
+	now := time.Now()
+	result, pending := planImport(rows, existing, userID, now)
+	result.DryRun = request.DryRun
+
+	if request.DryRun || len(pending) == 0 {
+		return result, nil
+	}
+
+	quietHours, serviceErr := s.findQuietHours(ctx, userID)
+	if serviceErr != nil {
+		return ImportResult{}, serviceErr
+	}
+	searchConfig := s.userSearchConfig(ctx, userID)
+
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return ImportResult{}, NewInternalServiceError(err)
+	}
+
+	events := make([]*missionservice.MissionEvent, 0, len(pending))
+	for _, i := range pending {
+		reminders, err := scheduleReminders(quietHours, result.Rows[i].Assignment.DueDate, result.Rows[i].Assignment.Reminders)
+		if err != nil {
+			tx.Rollback()
+			return ImportResult{}, NewInternalServiceError(err)
+		}
+
+		result.Rows[i].Assignment.SearchConfig = searchConfig
+		assignment, err := s.insertAssignmentWithTransaction(ctx, tx, userID, *result.Rows[i].Assignment, reminders, nil)
+		if err != nil {
+			tx.Rollback()
+			return ImportResult{}, NewInternalServiceError(err)
+		}
+
+		result.Rows[i].Assignment = &assignment
+		result.Rows[i].Status = ImportRowStatusCreated
+		result.Created++
+
+		events = append(events, newAssignmentMissionEvent(missionservice.TriggerMissionEvent_MISSION_EVENT_CREATE_ASSIGNMENT, assignment, now))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ImportResult{}, NewInternalServiceError(err)
+	}
+
+	if err := s.triggerMissionEvents(ctx, userID, events); err != nil {
+		return ImportResult{}, NewInternalServiceError(err)
+	}
+
+	return result, nil
+}
+
+func planImport(rows []importRow, existing []Assignment, userID uuid.UUID, now time.Time) (ImportResult, []int) {
+	seen := make(map[string]bool, len(existing)+len(rows))
+	for _, assignment := range existing {
+		seen[importDuplicateKey(assignment.Title, assignment.DueDate)] = true
+	}
+
+	result := ImportResult{
+		Total: len(rows),
+		Rows:  make([]ImportRowResult, len(rows)),
+	}
+	pending := make([]int, 0, len(rows))
+
+	for i, row := range rows {
+		result.Rows[i] = ImportRowResult{Row: row.row}
+
+		if row.err != nil {
+			result.Rows[i].Status = ImportRowStatusError
+			result.Rows[i].Error = row.err.Error()
+			result.Errors++
+			continue
+		}
+
+		assignment := row.toAssignment(userID, now)
+		result.Rows[i].Assignment = &assignment
+
+		key := importDuplicateKey(assignment.Title, assignment.DueDate)
+		if seen[key] {
+			result.Rows[i].Status = ImportRowStatusDuplicate
+			result.Duplicates++
+			continue
+		}
+		seen[key] = true
+
+		result.Rows[i].Status = ImportRowStatusReady
+		pending = append(pending, i)
+	}
+
+	result.Ready = len(pending)
+
+	return result, pending
+}
+
+func (r importRow) toAssignment(userID uuid.UUID, now time.Time) Assignment {
+	var reminders []Reminder
+	for _, reminder := range r.reminders {
+		if reminder.After(now) {
+			reminders = append(reminders, Reminder{Date: reminder})
+		}
+	}
+
+	note := r.note
+	isImportant := r.isImportant
+	isCompleted := r.isCompleted
+
+	return Assignment{
+		UserID:      userID,
+		Title:       r.title,
+		Note:        &note,
+		DueDate:     r.dueDate,
+		IsImportant: &isImportant,
+		IsCompleted: &isCompleted,
+		Reminders:   reminders,
+	}
+}
+
+func importDuplicateKey(title string, dueDate *time.Time) string {
+	key := strings.ToLower(strings.TrimSpace(title)) + "|"
+	if dueDate != nil {
+		key += dueDate.UTC().Truncate(time.Minute).Format(time.RFC3339)
+	}
+	return key
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newMissionEvent(event missionservice.TriggerMissionEvent, sourceID int32, amount int32, occurredAt time.Time) *missionservice.MissionEvent {
+	return &missionservice.MissionEvent{
+		EventId:    uuid.NewString(),
+		Event:      event,
+		Amount:     amount,
+		OccurredAt: timestamppb.New(occurredAt),
+		SourceId:   strconv.FormatInt(int64(sourceID), 10),
+	}
+}
+
+func newAssignmentMissionEvent(event missionservice.TriggerMissionEvent, assignment Assignment, occurredAt time.Time) *missionservice.MissionEvent {
+	missionEvent := newMissionEvent(event, assignment.ID, 1, occurredAt)
+	missionEvent.Attributes = &missionservice.MissionEventAttributes{
+		IsImportant: assignment.IsImportant != nil && *assignment.IsImportant,
+	}
+
+	if assignment.DueDate == nil {
+		return missionEvent
+	}
+
+	missionEvent.Attributes.DueDate = timestamppb.New(*assignment.DueDate)
+	if event == missionservice.TriggerMissionEvent_MISSION_EVENT_DONE_ASSIGNMENT {
+		completedAt := occurredAt
+		if assignment.CompletedAt != nil {
+			completedAt = *assignment.CompletedAt
+		}
+
+		missionEvent.Attributes.Completion = missionservice.MissionEventCompletion_MISSION_EVENT_COMPLETION_EARLY
+		if completedAt.After(*assignment.DueDate) {
+			missionEvent.Attributes.Completion = missionservice.MissionEventCompletion_MISSION_EVENT_COMPLETION_LATE
+		}
+	}
+
+	return missionEvent
+}
@@ -0,0 +1,362 @@
+package app
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	errQuickAddMissingTitle   = errors.New("text does not contain a title")
+	errQuickAddMissingDueDate = errors.New("text does not contain a due date")
+)
+
+var (
+	quickAddOffsetPattern  = regexp.MustCompile(`^(\d+)\s*([a-z]+)$`)
+	quickAddClockPattern   = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?\s*(am|pm)?$`)
+	quickAddOrdinalPattern = regexp.MustCompile(`^(\d{1,2})(?:st|nd|rd|th)?$`)
+)
+
+var quickAddWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+var quickAddMonths = map[string]time.Month{
+	"jan": time.January, "january": time.January,
+	"feb": time.February, "february": time.February,
+	"mar": time.March, "march": time.March,
+	"apr": time.April, "april": time.April,
+	"may": time.May,
+	"jun": time.June, "june": time.June,
+	"jul": time.July, "july": time.July,
+	"aug": time.August, "august": time.August,
+	"sep": time.September, "sept": time.September, "september": time.September,
+	"oct": time.October, "october": time.October,
+	"nov": time.November, "november": time.November,
+	"dec": time.December, "december": time.December,
+}
+
+var quickAddUnits = map[string]time.Duration{
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hrs": time.Hour, "hour": time.Hour, "hours": time.Hour,
+	"d": 24 * time.Hour, "day": 24 * time.Hour, "days": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour, "wk": 7 * 24 * time.Hour, "wks": 7 * 24 * time.Hour, "week": 7 * 24 * time.Hour, "weeks": 7 * 24 * time.Hour,
+}
+
+const (
+	quickAddDefaultHour   = 23
+	quickAddDefaultMinute = 59
+)
+
+type QuickAddRequest struct {
+	Text    string `json:"text" validate:"required,max=1000"`
+	Preview bool   `json:"preview"`
+}
+
+type QuickAddResult struct {
+	Parsed     CreateAssignmentRequest `json:"parsed"`
+	Assignment *Assignment             `json:"assignment,omitempty"`
+}
+
+func parseQuickAdd(text string, now time.Time) (CreateAssignmentRequest, error) {
+	words := strings.Fields(text)
+
+	var (
+		title       []string
+		dueDate     *time.Time
+		isImportant bool
+		reminders   []CreateReminderRequest
+	)
+
+	for i := 0; i < len(words); i++ {
+		word := quickAddWord(words[i])
+
+		switch {
+		case word == "!" || word == "!!" || word == "!important":
+			isImportant = true
+			continue
+		case word == "remind" || word == "reminder" || word == "reminders":
+			if offsets, consumed := parseQuickAddOffsets(words[i+1:]); consumed > 0 {
+				for _, offset := range offsets {
+					reminders = append(reminders, CreateReminderRequest{BeforeDueMinutes: &offset})
+				}
+				i += consumed
+				continue
+			}
+		case word == "due" || word == "by":
+			if due, consumed := parseQuickAddDate(words[i+1:], now); consumed > 0 {
+				dueDate = &due
+				i += consumed
+				continue
+			}
+		}
+
+		title = append(title, words[i])
+	}
+
+	request := CreateAssignmentRequest{
+		Title:       strings.Join(title, " "),
+		Note:        new(string),
+		DueDate:     dueDate,
+		IsImportant: &isImportant,
+		Reminders:   reminders,
+	}
+
+	if request.Title == "" {
+		return request, errQuickAddMissingTitle
+	}
+	if request.DueDate == nil {
+		return request, errQuickAddMissingDueDate
+	}
+
+	return request, nil
+}
+
+func quickAddWord(word string) string {
+	return strings.TrimRight(strings.ToLower(word), ",.;")
+}
+
+func parseQuickAddOffsets(words []string) ([]int32, int) {
+	var (
+		offsets  []int32
+		consumed int
+	)
+
+	for i := 0; i < len(words); i++ {
+		word := quickAddWord(words[i])
+		if word == "and" && len(offsets) > 0 {
+			continue
+		}
+		if word == "before" && len(offsets) > 0 {
+			return offsets, i + 1
+		}
+
+		if i+1 < len(words) {
+			if _, err := strconv.Atoi(word); err == nil {
+				word += quickAddWord(words[i+1])
+				if offset, ok := parseQuickAddOffset(word); ok {
+					offsets = append(offsets, offset)
+					i++
+					consumed = i + 1
+					continue
+				}
+				break
+			}
+		}
+
+		offset, ok := parseQuickAddOffset(word)
+		if !ok {
+			break
+		}
+		offsets = append(offsets, offset)
+		consumed = i + 1
+	}
+
+	return offsets, consumed
+}
+
+func parseQuickAddOffset(word string) (int32, bool) {
+	match := quickAddOffsetPattern.FindStringSubmatch(word)
+	if match == nil {
+		return 0, false
+	}
+
+	unit, ok := quickAddUnits[match[2]]
+	if !ok {
+		return 0, false
+	}
+
+	amount, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, false
+	}
+
+	minutes := time.Duration(amount) * unit / time.Minute
+	if minutes > 525600 {
+		return 0, false
+	}
+
+	return int32(minutes), true
+}
+
+func parseQuickAddDate(words []string, now time.Time) (time.Time, int) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var (
+		day      *time.Time
+		consumed int
+	)
+
+	word := func(i int) string {
+		if i < len(words) {
+			return quickAddWord(words[i])
+		}
+		return ""
+	}
+
+	switch first := word(0); {
+	case first == "today" || first == "tonight":
+		day, consumed = &today, 1
+	case first == "tomorrow" || first == "tmr" || first == "tmrw":
+		d := today.AddDate(0, 0, 1)
+		day, consumed = &d, 1
+	case first == "in":
+		if amount, err := strconv.Atoi(word(1)); err == nil {
+			if unit, ok := quickAddUnits[word(2)]; ok {
+				return now.Add(time.Duration(amount) * unit).Truncate(time.Minute), 3
+			}
+		}
+		if offset, ok := parseQuickAddOffset(word(1)); ok {
+			return now.Add(time.Duration(offset) * time.Minute).Truncate(time.Minute), 2
+		}
+	case first == "next":
+		if weekday, ok := quickAddWeekdays[word(1)]; ok {
+			daysUntilMonday := (int(time.Monday-today.Weekday()) + 7) % 7
+			if daysUntilMonday == 0 {
+				daysUntilMonday = 7
+			}
+			d := today.AddDate(0, 0, daysUntilMonday+(int(weekday)+6)%7)
+			day, consumed = &d, 2
+		} else if word(1) == "week" {
+			daysUntilMonday := (int(time.Monday-today.Weekday()) + 7) % 7
+			if daysUntilMonday == 0 {
+				daysUntilMonday = 7
+			}
+			d := today.AddDate(0, 0, daysUntilMonday)
+			day, consumed = &d, 2
+		}
+	default:
+		if weekday, ok := quickAddWeekdays[first]; ok {
+			days := (int(weekday-today.Weekday()) + 7) % 7
+			if days == 0 {
+				days = 7
+			}
+			d := today.AddDate(0, 0, days)
+			day, consumed = &d, 1
+		} else if d, err := time.ParseInLocation(time.DateOnly, first, now.Location()); err == nil {
+			day, consumed = &d, 1
+		} else if month, ok := quickAddMonths[first]; ok {
+			if d, ok := quickAddMonthDay(today, month, word(1)); ok {
+				day, consumed = &d, 2
+			}
+		} else if month, ok := quickAddMonths[word(1)]; ok {
+			if d, ok := quickAddMonthDay(today, month, first); ok {
+				day, consumed = &d, 2
+			}
+		}
+	}
+
+	next := consumed
+	if word(next) == "at" {
+		next++
+	}
+
+	hour, minute, clockConsumed := parseQuickAddClock(words[min(next, len(words)):])
+	if clockConsumed == 0 {
+		if day == nil {
+			return time.Time{}, 0
+		}
+		hour, minute = quickAddDefaultHour, quickAddDefaultMinute
+		if word(0) == "tonight" {
+			hour, minute = 20, 0
+		}
+		return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, now.Location()), consumed
+	}
+
+	if day == nil {
+		due := time.Date(today.Year(), today.Month(), today.Day(), hour, minute, 0, 0, now.Location())
+		if !due.After(now) {
+			due = due.AddDate(0, 0, 1)
+		}
+		return due, next + clockConsumed
+	}
+
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, now.Location()), next + clockConsumed
+}
+
+func quickAddMonthDay(today time.Time, month time.Month, word string) (time.Time, bool) {
+	match := quickAddOrdinalPattern.FindStringSubmatch(word)
+	if match == nil {
+		return time.Time{}, false
+	}
+
+	dayOfMonth, _ := strconv.Atoi(match[1])
+	if dayOfMonth < 1 || dayOfMonth > 31 {
+		return time.Time{}, false
+	}
+
+	d := time.Date(today.Year(), month, dayOfMonth, 0, 0, 0, 0, today.Location())
+	if d.Day() != dayOfMonth {
+		return time.Time{}, false
+	}
+	if d.Before(today) {
+		d = d.AddDate(1, 0, 0)
+	}
+
+	return d, true
+}
+
+func parseQuickAddClock(words []string) (int, int, int) {
+	if len(words) == 0 {
+		return 0, 0, 0
+	}
+
+	word := quickAddWord(words[0])
+	switch word {
+	case "noon":
+		return 12, 0, 1
+	case "midnight":
+		return 23, 59, 1
+	}
+
+	consumed := 1
+	if len(words) > 1 {
+		if next := quickAddWord(words[1]); next == "am" || next == "pm" {
+			word += next
+			consumed = 2
+		}
+	}
+
+	match := quickAddClockPattern.FindStringSubmatch(word)
+	if match == nil || (match[2] == "" && match[3] == "") {
+		return 0, 0, 0
+	}
+
+	hour, _ := strconv.Atoi(match[1])
+	minute := 0
+	if match[2] != "" {
+		minute, _ = strconv.Atoi(match[2])
+	}
+
+	switch match[3] {
+	case "am":
+		if hour < 1 || hour > 12 {
+			return 0, 0, 0
+		}
+		if hour == 12 {
+			hour = 0
+		}
+	case "pm":
+		if hour < 1 || hour > 12 {
+			return 0, 0, 0
+		}
+		if hour != 12 {
+			hour += 12
+		}
+	}
+
+	if hour > 23 || minute > 59 {
+		return 0, 0, 0
+	}
+
+	return hour, minute, consumed
+}
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) QuickAddAssignment(ctx context.Context, userID uuid.UUID, request QuickAddRequest) (QuickAddResult, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.QuickAddAssignment")
+	defer span.End()
+
+	now := time.Now().In(s.userLocation(ctx, userID))
+
+	parsed, err := parseQuickAdd(request.Text, now)
+	if errors.Is(err, errQuickAddMissingTitle) {
+		return QuickAddResult{}, NewClientErrorWithData(http.StatusUnprocessableEntity, err, map[string]string{
+			"Text": "The Text field must contain a title",
+		})
+	}
+	if errors.Is(err, errQuickAddMissingDueDate) {
+		return QuickAddResult{}, NewClientErrorWithData(http.StatusUnprocessableEntity, err, map[string]string{
+			"Text": `The Text field must contain a due date such as "due fri 5pm"`,
+		})
+	}
+	if err != nil {
+		return QuickAddResult{}, NewInternalServiceError(err)
+	}
+
+	if request.Preview {
+		return QuickAddResult{Parsed: parsed}, nil
+	}
+
+	assignment, serviceErr := s.CreateAssignment(ctx, userID, parsed)
+	if serviceErr != nil {
+		return QuickAddResult{}, serviceErr
+	}
+
+	return QuickAddResult{Parsed: parsed, Assignment: &assignment}, nil
+}
@@ -0,0 +1,253 @@
+package app
+
+import (
+	"slices"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseQuickAdd(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Saturday, the day before daylight saving time starts in New York.
+	saturday := time.Date(2026, time.March, 7, 10, 0, 0, 0, newYork)
+	// Friday evening in New York is already Saturday in Tokyo.
+	instant := time.Date(2026, time.March, 7, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		text      string
+		now       time.Time
+		title     string
+		dueDate   time.Time
+		important bool
+		reminders []int32
+		err       error
+	}{
+		{
+			name:      "full sentence",
+			text:      "Physics essay due next Fri 5pm !important remind 2d before",
+			now:       saturday,
+			title:     "Physics essay",
+			dueDate:   time.Date(2026, time.March, 13, 17, 0, 0, 0, newYork),
+			important: true,
+			reminders: []int32{2880},
+		},
+		{
+			name:    "tomorrow defaults to end of day",
+			text:    "Call mom due tomorrow",
+			now:     saturday,
+			title:   "Call mom",
+			dueDate: time.Date(2026, time.March, 8, 23, 59, 0, 0, newYork),
+		},
+		{
+			name:    "tomorrow crosses daylight saving time",
+			text:    "Pay rent due tomorrow 9am",
+			now:     saturday,
+			title:   "Pay rent",
+			dueDate: time.Date(2026, time.March, 8, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "tonight",
+			text:    "Party due tonight",
+			now:     saturday,
+			title:   "Party",
+			dueDate: time.Date(2026, time.March, 7, 20, 0, 0, 0, newYork),
+		},
+		{
+			name:    "relative offset",
+			text:    "Standup due in 2 hours",
+			now:     saturday,
+			title:   "Standup",
+			dueDate: time.Date(2026, time.March, 7, 12, 0, 0, 0, newYork),
+		},
+		{
+			name:    "compact relative offset",
+			text:    "Standup due in 90min",
+			now:     saturday,
+			title:   "Standup",
+			dueDate: time.Date(2026, time.March, 7, 11, 30, 0, 0, newYork),
+		},
+		{
+			name:    "clock later today",
+			text:    "Gym by 7pm",
+			now:     saturday,
+			title:   "Gym",
+			dueDate: time.Date(2026, time.March, 7, 19, 0, 0, 0, newYork),
+		},
+		{
+			name:    "clock already passed rolls to tomorrow",
+			text:    "Breakfast by 8am",
+			now:     saturday,
+			title:   "Breakfast",
+			dueDate: time.Date(2026, time.March, 8, 8, 0, 0, 0, newYork),
+		},
+		{
+			name:    "noon",
+			text:    "Lunch due sunday at noon",
+			now:     saturday,
+			title:   "Lunch",
+			dueDate: time.Date(2026, time.March, 8, 12, 0, 0, 0, newYork),
+		},
+		{
+			name:    "same weekday means next week",
+			text:    "Review due sat",
+			now:     saturday,
+			title:   "Review",
+			dueDate: time.Date(2026, time.March, 14, 23, 59, 0, 0, newYork),
+		},
+		{
+			name:    "next week",
+			text:    "Plan due next week",
+			now:     saturday,
+			title:   "Plan",
+			dueDate: time.Date(2026, time.March, 9, 23, 59, 0, 0, newYork),
+		},
+		{
+			name:    "iso date",
+			text:    "Essay due 2026-04-01 14:30",
+			now:     saturday,
+			title:   "Essay",
+			dueDate: time.Date(2026, time.April, 1, 14, 30, 0, 0, newYork),
+		},
+		{
+			name:    "past month day rolls to next year",
+			text:    "Report due mar 1st",
+			now:     saturday,
+			title:   "Report",
+			dueDate: time.Date(2027, time.March, 1, 23, 59, 0, 0, newYork),
+		},
+		{
+			name:    "day before month",
+			text:    "Report due 15 april",
+			now:     saturday,
+			title:   "Report",
+			dueDate: time.Date(2026, time.April, 15, 23, 59, 0, 0, newYork),
+		},
+		{
+			name:    "tomorrow in new york",
+			text:    "Submit due tomorrow",
+			now:     instant.In(newYork),
+			title:   "Submit",
+			dueDate: time.Date(2026, time.March, 7, 23, 59, 0, 0, newYork),
+		},
+		{
+			name:    "tomorrow in tokyo",
+			text:    "Submit due tomorrow",
+			now:     instant.In(tokyo),
+			title:   "Submit",
+			dueDate: time.Date(2026, time.March, 8, 23, 59, 0, 0, tokyo),
+		},
+		{
+			name:    "last due date wins",
+			text:    "Essay due tomorrow due fri",
+			now:     saturday,
+			title:   "Essay",
+			dueDate: time.Date(2026, time.March, 13, 23, 59, 0, 0, newYork),
+		},
+		{
+			name:      "repeated importance markers",
+			text:      "Essay ! due fri !!",
+			now:       saturday,
+			title:     "Essay",
+			dueDate:   time.Date(2026, time.March, 13, 23, 59, 0, 0, newYork),
+			important: true,
+		},
+		{
+			name:      "multiple reminders",
+			text:      "Exam due fri remind 1d and 2h before",
+			now:       saturday,
+			title:     "Exam",
+			dueDate:   time.Date(2026, time.March, 13, 23, 59, 0, 0, newYork),
+			reminders: []int32{1440, 120},
+		},
+		{
+			name:      "reminders accumulate",
+			text:      "Exam remind 30 min due fri reminder 1w",
+			now:       saturday,
+			title:     "Exam",
+			dueDate:   time.Date(2026, time.March, 13, 23, 59, 0, 0, newYork),
+			reminders: []int32{30, 10080},
+		},
+		{
+			name:    "keyword without date stays in title",
+			text:    "Pay due bills by friday",
+			now:     saturday,
+			title:   "Pay due bills",
+			dueDate: time.Date(2026, time.March, 13, 23, 59, 0, 0, newYork),
+		},
+		{
+			name:    "invalid clock is kept in title",
+			text:    "Meet due fri 25pm",
+			now:     saturday,
+			title:   "Meet 25pm",
+			dueDate: time.Date(2026, time.March, 13, 23, 59, 0, 0, newYork),
+		},
+		{
+			name:  "invalid calendar date",
+			text:  "Meet due feb 30",
+			now:   saturday,
+			title: "Meet due feb 30",
+			err:   errQuickAddMissingDueDate,
+		},
+		{
+			name:  "no recognized tokens",
+			text:  "Just some text",
+			now:   saturday,
+			title: "Just some text",
+			err:   errQuickAddMissingDueDate,
+		},
+		{
+			name:      "missing title",
+			text:      "due tomorrow !",
+			now:       saturday,
+			dueDate:   time.Date(2026, time.March, 8, 23, 59, 0, 0, newYork),
+			important: true,
+			err:       errQuickAddMissingTitle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := parseQuickAdd(tt.text, tt.now)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("error = %v, want %v", err, tt.err)
+			}
+
+			if request.Title != tt.title {
+				t.Errorf("title = %q, want %q", request.Title, tt.title)
+			}
+
+			switch {
+			case tt.dueDate.IsZero() && request.DueDate != nil:
+				t.Errorf("due date = %v, want none", *request.DueDate)
+			case !tt.dueDate.IsZero() && request.DueDate == nil:
+				t.Errorf("due date = none, want %v", tt.dueDate)
+			case !tt.dueDate.IsZero() && !request.DueDate.Equal(tt.dueDate):
+				t.Errorf("due date = %v, want %v", *request.DueDate, tt.dueDate)
+			}
+
+			if *request.IsImportant != tt.important {
+				t.Errorf("important = %v, want %v", *request.IsImportant, tt.important)
+			}
+
+			var reminders []int32
+			for _, reminder := range request.Reminders {
+				reminders = append(reminders, *reminder.BeforeDueMinutes)
+			}
+			if !slices.Equal(reminders, tt.reminders) {
+				t.Errorf("reminders = %v, want %v", reminders, tt.reminders)
+			}
+		})
+	}
+}
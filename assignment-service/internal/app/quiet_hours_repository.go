@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var QuietHoursTable = table.QuietHours.AS("quiet_hours")
+
+type QuietHoursRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewQuietHoursRepository(db *database.Service, tracer trace.Tracer) *QuietHoursRepository {
+	return &QuietHoursRepository{db, tracer}
+}
+
+func (r *QuietHoursRepository) UpsertQuietHours(ctx context.Context, quietHours QuietHours) (QuietHours, error) {
+	ctx, span := r.tracer.Start(ctx, "QuietHoursRepository.UpsertQuietHours")
+	defer span.End()
+
+	query := QuietHoursTable.INSERT(QuietHoursTable.UserID, QuietHoursTable.StartsAt, QuietHoursTable.EndsAt, QuietHoursTable.Timezone).
+		VALUES(quietHours.UserID, quietHours.StartsAt, quietHours.EndsAt, quietHours.Timezone).
+		ON_CONFLICT(QuietHoursTable.UserID).
+		DO_UPDATE(SET(
+			QuietHoursTable.StartsAt.SET(QuietHoursTable.EXCLUDED.StartsAt),
+			QuietHoursTable.EndsAt.SET(QuietHoursTable.EXCLUDED.EndsAt),
+			QuietHoursTable.Timezone.SET(QuietHoursTable.EXCLUDED.Timezone),
+			QuietHoursTable.UpdatedAt.SET(LOCALTIMESTAMP()),
+		)).
+		RETURNING(QuietHoursTable.AllColumns)
+
+	var q QuietHours
+	err := query.QueryContext(ctx, r.db.Pool, &q)
+
+	return q, err
+}
+
+func (r *QuietHoursRepository) FindQuietHoursByUserID(ctx context.Context, userID uuid.UUID) (QuietHours, error) {
+	ctx, span := r.tracer.Start(ctx, "QuietHoursRepository.FindQuietHoursByUserID")
+	defer span.End()
+
+	query := QuietHoursTable.SELECT(QuietHoursTable.AllColumns).
+		FROM(QuietHoursTable).
+		WHERE(QuietHoursTable.UserID.EQ(UUID(userID)))
+
+	var q QuietHours
+	err := query.QueryContext(ctx, r.db.Pool, &q)
+
+	return q, err
+}
+
+func (r *QuietHoursRepository) DeleteQuietHoursByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "QuietHoursRepository.DeleteQuietHoursByUserID")
+	defer span.End()
+
+	query := QuietHoursTable.DELETE().
+		WHERE(QuietHoursTable.UserID.EQ(UUID(userID)))
+
+	result, err := query.ExecContext(ctx, r.db.Pool)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
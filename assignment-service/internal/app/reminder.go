@@ -1,15 +1,123 @@
 package app
 
-import "time"
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const quietHoursLayout = "15:04"
+
+var errRelativeReminderWithoutDueDate = errors.New("relative reminders require a due date")
 
 type Reminder struct {
-	ID           int32      `json:"id" sql:"primary_key"`
-	AssignmentID int32      `json:"assignment_id"`
-	Date         time.Time  `json:"date"`
-	CreatedAt    *time.Time `json:"created_at"`
-	UpdatedAt    *time.Time `json:"updated_at"`
+	ID               int32      `json:"id" sql:"primary_key"`
+	AssignmentID     int32      `json:"assignment_id"`
+	Date             time.Time  `json:"date"`
+	BeforeDueMinutes *int32     `json:"before_due_minutes"`
+	CreatedAt        *time.Time `json:"created_at"`
+	UpdatedAt        *time.Time `json:"updated_at"`
+}
+
+func (r Reminder) relative() bool {
+	return r.BeforeDueMinutes != nil
+}
+
+func scheduleReminders(quietHours *QuietHours, dueDate *time.Time, reminders []Reminder) ([]Reminder, error) {
+	scheduled := make([]Reminder, len(reminders))
+	for i, reminder := range reminders {
+		if reminder.relative() {
+			if dueDate == nil {
+				return nil, errRelativeReminderWithoutDueDate
+			}
+			reminder.Date = dueDate.Add(-time.Duration(*reminder.BeforeDueMinutes) * time.Minute)
+		}
+		if quietHours != nil {
+			reminder.Date = quietHours.release(reminder.Date)
+		}
+		scheduled[i] = reminder
+	}
+
+	return scheduled, nil
+}
+
+func sortReminders(reminders []Reminder) {
+	slices.SortFunc(reminders, func(a, b Reminder) int {
+		return a.Date.Compare(b.Date)
+	})
 }
 
 type CreateReminderRequest struct {
-	Date time.Time `json:"date" validate:"required,future"`
+	Date             *time.Time `json:"date" validate:"required_without=BeforeDueMinutes,excluded_with=BeforeDueMinutes,omitempty,future"`
+	BeforeDueMinutes *int32     `json:"before_due_minutes" validate:"omitempty,min=0,max=525600"`
+}
+
+func (r CreateReminderRequest) toReminder() Reminder {
+	reminder := Reminder{BeforeDueMinutes: r.BeforeDueMinutes}
+	if r.Date != nil {
+		reminder.Date = *r.Date
+	}
+
+	return reminder
+}
+
+type SnoozeReminderRequest struct {
+	Minutes int32 `json:"minutes" validate:"required,min=1,max=10080"`
+}
+
+type QuietHours struct {
+	UserID    uuid.UUID  `json:"user_id" sql:"primary_key"`
+	StartsAt  string     `json:"starts_at"`
+	EndsAt    string     `json:"ends_at"`
+	Timezone  string     `json:"timezone"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+func (q QuietHours) release(t time.Time) time.Time {
+	location, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return t
+	}
+
+	startsAt, err := time.Parse(quietHoursLayout, q.StartsAt)
+	if err != nil {
+		return t
+	}
+
+	endsAt, err := time.Parse(quietHoursLayout, q.EndsAt)
+	if err != nil {
+		return t
+	}
+
+	local := t.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	start := startsAt.Hour()*60 + startsAt.Minute()
+	end := endsAt.Hour()*60 + endsAt.Minute()
+
+	var quiet bool
+	switch {
+	case start < end:
+		quiet = minute >= start && minute < end
+	case start > end:
+		quiet = minute >= start || minute < end
+	}
+	if !quiet {
+		return t
+	}
+
+	released := time.Date(local.Year(), local.Month(), local.Day(), endsAt.Hour(), endsAt.Minute(), 0, 0, location)
+	if !released.After(local) {
+		released = released.AddDate(0, 0, 1)
+	}
+
+	return released
+}
+
+type UpdateQuietHoursRequest struct {
+	StartsAt string `json:"starts_at" validate:"required,datetime=15:04"`
+	EndsAt   string `json:"ends_at" validate:"required,datetime=15:04"`
+	Timezone string `json:"timezone" validate:"omitempty,timezone"`
 }
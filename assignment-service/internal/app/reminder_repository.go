@@ -3,8 +3,10 @@ package app
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
@@ -27,8 +29,8 @@ func (r *ReminderRepository) InsertReminder(ctx context.Context, reminder Remind
 	ctx, span := r.tracer.Start(ctx, "ReminderRepository.InsertReminder")
 	defer span.End()
 
-	query := ReminderTable.INSERT(ReminderTable.AssignmentID, ReminderTable.Date).
-		VALUES(reminder.AssignmentID, reminder.Date).
+	query := ReminderTable.INSERT(ReminderTable.AssignmentID, ReminderTable.Date, ReminderTable.BeforeDueMinutes).
+		VALUES(reminder.AssignmentID, reminder.Date, reminder.BeforeDueMinutes).
 		RETURNING(ReminderTable.AllColumns)
 
 	var rd Reminder
@@ -41,11 +43,11 @@ func (r *ReminderRepository) InsertRemindersWithTransaction(ctx context.Context,
 	ctx, span := r.tracer.Start(ctx, "ReminderRepository.InsertRemindersWithTransaction")
 	defer span.End()
 
-	query := ReminderTable.INSERT(ReminderTable.AssignmentID, ReminderTable.Date).
+	query := ReminderTable.INSERT(ReminderTable.AssignmentID, ReminderTable.Date, ReminderTable.BeforeDueMinutes).
 		RETURNING(ReminderTable.AllColumns)
 
 	for _, rem := range reminders {
-		query = query.VALUES(rem.AssignmentID, rem.Date)
+		query = query.VALUES(rem.AssignmentID, rem.Date, rem.BeforeDueMinutes)
 	}
 
 	var insertedReminders []Reminder
@@ -65,3 +67,101 @@ func (r *ReminderRepository) DeleteRemindersByAssignmentIDWithTransaction(ctx co
 
 	return err
 }
+
+func (r *ReminderRepository) DeleteRemindersByAssignmentIDsWithTransaction(ctx context.Context, tx *sql.Tx, assignmentIDs []int32) error {
+	ctx, span := r.tracer.Start(ctx, "ReminderRepository.DeleteRemindersByAssignmentIDsWithTransaction")
+	defer span.End()
+
+	query := ReminderTable.DELETE().
+		WHERE(ReminderTable.AssignmentID.IN(int32Expressions(assignmentIDs)...))
+
+	_, err := query.ExecContext(ctx, tx)
+
+	return err
+}
+
+func (r *ReminderRepository) DeleteRemindersByIDsWithTransaction(ctx context.Context, tx *sql.Tx, reminderIDs []int32) error {
+	ctx, span := r.tracer.Start(ctx, "ReminderRepository.DeleteRemindersByIDsWithTransaction")
+	defer span.End()
+
+	query := ReminderTable.DELETE().
+		WHERE(ReminderTable.ID.IN(int32Expressions(reminderIDs)...))
+
+	_, err := query.ExecContext(ctx, tx)
+
+	return err
+}
+
+func (r *ReminderRepository) FindVisibleRemindersByIDsWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID, reminderIDs []int32) ([]Reminder, error) {
+	ctx, span := r.tracer.Start(ctx, "ReminderRepository.FindVisibleRemindersByIDsWithTransaction")
+	defer span.End()
+
+	query := SELECT(ReminderTable.AllColumns).
+		FROM(ReminderTable.INNER_JOIN(AssignmentTable, AssignmentTable.ID.EQ(ReminderTable.AssignmentID))).
+		WHERE(
+			ReminderTable.ID.IN(int32Expressions(reminderIDs)...).
+				AND(assignmentVisibleTo(userID)).
+				AND(AssignmentTable.DeletedAt.IS_NULL()),
+		)
+
+	reminders := []Reminder{}
+	err := query.QueryContext(ctx, tx, &reminders)
+
+	return reminders, err
+}
+
+func (r *ReminderRepository) UpdateReminderDate(ctx context.Context, reminderID int32, date time.Time) (Reminder, error) {
+	ctx, span := r.tracer.Start(ctx, "ReminderRepository.UpdateReminderDate")
+	defer span.End()
+
+	query := ReminderTable.UPDATE(ReminderTable.Date, ReminderTable.FiredAt, ReminderTable.UpdatedAt).
+		SET(date, NULL, time.Now()).
+		WHERE(ReminderTable.ID.EQ(Int32(reminderID))).
+		RETURNING(ReminderTable.AllColumns)
+
+	var reminder Reminder
+	err := query.QueryContext(ctx, r.db.Pool, &reminder)
+
+	return reminder, err
+}
+
+func (r *ReminderRepository) UpdateReminderDatesWithTransaction(ctx context.Context, tx *sql.Tx, reminders []Reminder) error {
+	ctx, span := r.tracer.Start(ctx, "ReminderRepository.UpdateReminderDatesWithTransaction")
+	defer span.End()
+
+	for _, reminder := range reminders {
+		query := ReminderTable.UPDATE(ReminderTable.Date, ReminderTable.FiredAt, ReminderTable.UpdatedAt).
+			SET(reminder.Date, NULL, time.Now()).
+			WHERE(ReminderTable.ID.EQ(Int32(reminder.ID)))
+
+		if _, err := query.ExecContext(ctx, tx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ReminderRepository) FireDueRemindersWithTransaction(ctx context.Context, tx *sql.Tx, from time.Time, to time.Time) ([]Reminder, error) {
+	ctx, span := r.tracer.Start(ctx, "ReminderRepository.FireDueRemindersWithTransaction")
+	defer span.End()
+
+	pendingAssignmentIDs := table.Assignments.SELECT(table.Assignments.ID).
+		FROM(table.Assignments).
+		WHERE(table.Assignments.DeletedAt.IS_NULL().AND(table.Assignments.IsCompleted.IS_NOT_TRUE()))
+
+	query := ReminderTable.UPDATE(ReminderTable.FiredAt).
+		SET(to).
+		WHERE(
+			ReminderTable.FiredAt.IS_NULL().
+				AND(ReminderTable.Date.GT(TimestampzT(from))).
+				AND(ReminderTable.Date.LT_EQ(TimestampzT(to))).
+				AND(ReminderTable.AssignmentID.IN(pendingAssignmentIDs)),
+		).
+		RETURNING(ReminderTable.AllColumns)
+
+	reminders := []Reminder{}
+	err := query.QueryContext(ctx, tx, &reminders)
+
+	return reminders, err
+}
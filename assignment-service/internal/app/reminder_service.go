@@ -0,0 +1,183 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) findQuietHours(ctx context.Context, userID uuid.UUID) (*QuietHours, ServiceError) {
+	quietHours, err := s.quietHoursRepository.FindQuietHoursByUserID(ctx, userID)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	if quietHours.Timezone == "" {
+		quietHours.Timezone = s.userLocation(ctx, userID).String()
+	}
+
+	return &quietHours, nil
+}
+
+func (s *Service) scheduleReminders(ctx context.Context, ownerID uuid.UUID, dueDate *time.Time, reminders []Reminder) ([]Reminder, ServiceError) {
+	if len(reminders) == 0 {
+		return reminders, nil
+	}
+
+	quietHours, serviceErr := s.findQuietHours(ctx, ownerID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	scheduled, err := scheduleReminders(quietHours, dueDate, reminders)
+	if errors.Is(err, errRelativeReminderWithoutDueDate) {
+		return nil, NewClientErrorWithData(http.StatusUnprocessableEntity, err, map[string]string{
+			"Reminders": "The Reminders field can only use before_due_minutes when the assignment has a due date",
+		})
+	}
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return scheduled, nil
+}
+
+func (s *Service) rescheduleRelativeRemindersWithTransaction(ctx context.Context, tx *sql.Tx, assignment Assignment) ([]Reminder, ServiceError) {
+	if assignment.DueDate == nil {
+		return assignment.Reminders, nil
+	}
+
+	reminders, serviceErr := s.scheduleReminders(ctx, assignment.UserID, assignment.DueDate, assignment.Reminders)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	var moved []Reminder
+	for i, reminder := range reminders {
+		if reminder.relative() && !reminder.Date.Equal(assignment.Reminders[i].Date) {
+			moved = append(moved, reminder)
+		} else {
+			reminders[i] = assignment.Reminders[i]
+		}
+	}
+
+	if err := s.reminderRepository.UpdateReminderDatesWithTransaction(ctx, tx, moved); err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	sortReminders(reminders)
+
+	return reminders, nil
+}
+
+func (s *Service) SnoozeReminder(ctx context.Context, userID uuid.UUID, assignmentID int32, reminderID int32, request SnoozeReminderRequest) (Reminder, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.SnoozeReminder")
+	defer span.End()
+
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleEditor)
+	if serviceErr != nil {
+		return Reminder{}, serviceErr
+	}
+
+	var reminder *Reminder
+	for i := range assignment.Reminders {
+		if assignment.Reminders[i].ID == reminderID {
+			reminder = &assignment.Reminders[i]
+			break
+		}
+	}
+	if reminder == nil {
+		return Reminder{}, NewClientError(http.StatusNotFound, errors.New("reminder not found"))
+	}
+
+	now := time.Now()
+	if reminder.Date.After(now) {
+		return Reminder{}, NewClientError(http.StatusConflict, errors.New("reminder has not fired yet"))
+	}
+
+	quietHours, serviceErr := s.findQuietHours(ctx, assignment.UserID)
+	if serviceErr != nil {
+		return Reminder{}, serviceErr
+	}
+
+	snoozedUntil := now.Add(time.Duration(request.Minutes) * time.Minute)
+	if quietHours != nil {
+		snoozedUntil = quietHours.release(snoozedUntil)
+	}
+
+	snoozed, err := s.reminderRepository.UpdateReminderDate(ctx, reminder.ID, snoozedUntil)
+	if err != nil {
+		return Reminder{}, NewInternalServiceError(err)
+	}
+
+	return snoozed, nil
+}
+
+func (s *Service) GetQuietHours(ctx context.Context, userID uuid.UUID) (QuietHours, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetQuietHours")
+	defer span.End()
+
+	quietHours, serviceErr := s.findQuietHours(ctx, userID)
+	if serviceErr != nil {
+		return QuietHours{}, serviceErr
+	}
+	if quietHours == nil {
+		return QuietHours{}, NewClientError(http.StatusNotFound, errors.New("quiet hours not set"))
+	}
+
+	return *quietHours, nil
+}
+
+func (s *Service) UpdateQuietHours(ctx context.Context, userID uuid.UUID, request UpdateQuietHoursRequest) (QuietHours, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.UpdateQuietHours")
+	defer span.End()
+
+	startsAt, err := time.Parse(quietHoursLayout, request.StartsAt)
+	if err != nil {
+		return QuietHours{}, NewClientError(http.StatusBadRequest, err)
+	}
+
+	endsAt, err := time.Parse(quietHoursLayout, request.EndsAt)
+	if err != nil {
+		return QuietHours{}, NewClientError(http.StatusBadRequest, err)
+	}
+
+	quietHours, err := s.quietHoursRepository.UpsertQuietHours(ctx, QuietHours{
+		UserID:   userID,
+		StartsAt: startsAt.Format(quietHoursLayout),
+		EndsAt:   endsAt.Format(quietHoursLayout),
+		Timezone: request.Timezone,
+	})
+	if err != nil {
+		return QuietHours{}, NewInternalServiceError(err)
+	}
+
+	if quietHours.Timezone == "" {
+		quietHours.Timezone = s.userLocation(ctx, userID).String()
+	}
+
+	return quietHours, nil
+}
+
+func (s *Service) DeleteQuietHours(ctx context.Context, userID uuid.UUID) ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.DeleteQuietHours")
+	defer span.End()
+
+	deleted, err := s.quietHoursRepository.DeleteQuietHoursByUserID(ctx, userID)
+	if err != nil {
+		return NewInternalServiceError(err)
+	}
+	if deleted == 0 {
+		return NewClientError(http.StatusNotFound, errors.New("quiet hours not set"))
+	}
+
+	return nil
+}
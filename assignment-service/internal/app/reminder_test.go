@@ -0,0 +1,196 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	_ "time/tzdata"
+)
+
+func TestQuietHoursRelease(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	afternoon := QuietHours{StartsAt: "13:00", EndsAt: "14:00", Timezone: "UTC"}
+	overnight := QuietHours{StartsAt: "22:00", EndsAt: "07:00", Timezone: "UTC"}
+
+	tests := []struct {
+		name       string
+		quietHours QuietHours
+		at         time.Time
+		want       time.Time
+	}{
+		{
+			name:       "same-day window before start",
+			quietHours: afternoon,
+			at:         time.Date(2026, time.March, 7, 12, 59, 0, 0, time.UTC),
+			want:       time.Date(2026, time.March, 7, 12, 59, 0, 0, time.UTC),
+		},
+		{
+			name:       "same-day window at start",
+			quietHours: afternoon,
+			at:         time.Date(2026, time.March, 7, 13, 0, 0, 0, time.UTC),
+			want:       time.Date(2026, time.March, 7, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "same-day window last second",
+			quietHours: afternoon,
+			at:         time.Date(2026, time.March, 7, 13, 59, 59, 0, time.UTC),
+			want:       time.Date(2026, time.March, 7, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "same-day window at end",
+			quietHours: afternoon,
+			at:         time.Date(2026, time.March, 7, 14, 0, 0, 0, time.UTC),
+			want:       time.Date(2026, time.March, 7, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "overnight window before start",
+			quietHours: overnight,
+			at:         time.Date(2026, time.March, 7, 21, 59, 0, 0, time.UTC),
+			want:       time.Date(2026, time.March, 7, 21, 59, 0, 0, time.UTC),
+		},
+		{
+			name:       "overnight window before midnight",
+			quietHours: overnight,
+			at:         time.Date(2026, time.March, 7, 23, 30, 0, 0, time.UTC),
+			want:       time.Date(2026, time.March, 8, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "overnight window after midnight",
+			quietHours: overnight,
+			at:         time.Date(2026, time.March, 8, 2, 0, 0, 0, time.UTC),
+			want:       time.Date(2026, time.March, 8, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "overnight window at end",
+			quietHours: overnight,
+			at:         time.Date(2026, time.March, 8, 7, 0, 0, 0, time.UTC),
+			want:       time.Date(2026, time.March, 8, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "overnight window across month end",
+			quietHours: overnight,
+			at:         time.Date(2026, time.March, 31, 22, 15, 0, 0, time.UTC),
+			want:       time.Date(2026, time.April, 1, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "empty window",
+			quietHours: QuietHours{StartsAt: "09:00", EndsAt: "09:00", Timezone: "UTC"},
+			at:         time.Date(2026, time.March, 7, 9, 0, 0, 0, time.UTC),
+			want:       time.Date(2026, time.March, 7, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "window in the user's timezone",
+			quietHours: QuietHours{StartsAt: "22:00", EndsAt: "07:00", Timezone: "Asia/Jakarta"},
+			at:         time.Date(2026, time.March, 7, 16, 0, 0, 0, time.UTC),
+			want:       time.Date(2026, time.March, 8, 7, 0, 0, 0, jakarta),
+		},
+		{
+			name:       "outside the window in the user's timezone",
+			quietHours: QuietHours{StartsAt: "22:00", EndsAt: "07:00", Timezone: "Asia/Jakarta"},
+			at:         time.Date(2026, time.March, 7, 14, 0, 0, 0, time.UTC),
+			want:       time.Date(2026, time.March, 7, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "release across a daylight saving change",
+			quietHours: QuietHours{StartsAt: "23:00", EndsAt: "08:00", Timezone: "America/New_York"},
+			at:         time.Date(2026, time.March, 7, 23, 30, 0, 0, newYork),
+			want:       time.Date(2026, time.March, 8, 8, 0, 0, 0, newYork),
+		},
+		{
+			name:       "unknown timezone",
+			quietHours: QuietHours{StartsAt: "22:00", EndsAt: "07:00", Timezone: "Mars/Olympus_Mons"},
+			at:         time.Date(2026, time.March, 7, 23, 0, 0, 0, time.UTC),
+			want:       time.Date(2026, time.March, 7, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "malformed window",
+			quietHours: QuietHours{StartsAt: "10pm", EndsAt: "07:00", Timezone: "UTC"},
+			at:         time.Date(2026, time.March, 7, 23, 0, 0, 0, time.UTC),
+			want:       time.Date(2026, time.March, 7, 23, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.quietHours.release(tt.at); !got.Equal(tt.want) {
+				t.Errorf("release(%s) = %s, want %s", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleReminders(t *testing.T) {
+	dueDate := time.Date(2026, time.March, 8, 9, 0, 0, 0, time.UTC)
+	quietHours := &QuietHours{StartsAt: "22:00", EndsAt: "07:00", Timezone: "UTC"}
+	beforeDue := func(minutes int32) *int32 { return &minutes }
+
+	tests := []struct {
+		name       string
+		quietHours *QuietHours
+		dueDate    *time.Time
+		reminders  []Reminder
+		want       []time.Time
+		err        error
+	}{
+		{
+			name:      "absolute reminder",
+			dueDate:   &dueDate,
+			reminders: []Reminder{{Date: time.Date(2026, time.March, 7, 23, 0, 0, 0, time.UTC)}},
+			want:      []time.Time{time.Date(2026, time.March, 7, 23, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:      "relative reminder",
+			dueDate:   &dueDate,
+			reminders: []Reminder{{BeforeDueMinutes: beforeDue(60)}},
+			want:      []time.Time{time.Date(2026, time.March, 8, 8, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:       "reminders inside quiet hours are released",
+			quietHours: quietHours,
+			dueDate:    &dueDate,
+			reminders: []Reminder{
+				{Date: time.Date(2026, time.March, 7, 23, 0, 0, 0, time.UTC)},
+				{BeforeDueMinutes: beforeDue(240)},
+				{BeforeDueMinutes: beforeDue(30)},
+			},
+			want: []time.Time{
+				time.Date(2026, time.March, 8, 7, 0, 0, 0, time.UTC),
+				time.Date(2026, time.March, 8, 7, 0, 0, 0, time.UTC),
+				time.Date(2026, time.March, 8, 8, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:      "relative reminder without a due date",
+			reminders: []Reminder{{BeforeDueMinutes: beforeDue(60)}},
+			err:       errRelativeReminderWithoutDueDate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduled, err := scheduleReminders(tt.quietHours, tt.dueDate, tt.reminders)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("scheduleReminders() error = %v, want %v", err, tt.err)
+			}
+			if len(scheduled) != len(tt.want) {
+				t.Fatalf("scheduled %d reminders, want %d", len(scheduled), len(tt.want))
+			}
+
+			for i, reminder := range scheduled {
+				if !reminder.Date.Equal(tt.want[i]) {
+					t.Errorf("reminder %d at %s, want %s", i, reminder.Date, tt.want[i])
+				}
+			}
+		})
+	}
+}
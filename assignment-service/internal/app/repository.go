@@ -22,3 +22,7 @@ func (r *Repository) Health(ctx context.Context) map[string]string {
 func (r *Repository) BeginTransaction(ctx context.Context) (*sql.Tx, error) {
 	return r.db.Pool.BeginTx(ctx, nil)
 }
+
+func (r *Repository) BeginSnapshotTransaction(ctx context.Context) (*sql.Tx, error) {
+	return r.db.Pool.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+}
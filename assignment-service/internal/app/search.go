@@ -0,0 +1,85 @@
+package app
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	searchDefaultLimit = 20
+	searchMaxLimit     = 100
+
+	searchDefaultConfig  = "english"
+	searchFallbackConfig = "simple"
+)
+
+// searchConfigs maps the base language of a BCP 47 locale to the built-in
+// Postgres text search configuration that stems it.
+var searchConfigs = map[string]string{
+	"ar": "arabic",
+	"ca": "catalan",
+	"da": "danish",
+	"de": "german",
+	"el": "greek",
+	"en": "english",
+	"es": "spanish",
+	"eu": "basque",
+	"fi": "finnish",
+	"fr": "french",
+	"ga": "irish",
+	"hi": "hindi",
+	"hu": "hungarian",
+	"hy": "armenian",
+	"id": "indonesian",
+	"it": "italian",
+	"lt": "lithuanian",
+	"nb": "norwegian",
+	"ne": "nepali",
+	"nl": "dutch",
+	"nn": "norwegian",
+	"no": "norwegian",
+	"pt": "portuguese",
+	"ro": "romanian",
+	"ru": "russian",
+	"sr": "serbian",
+	"sv": "swedish",
+	"ta": "tamil",
+	"tr": "turkish",
+	"yi": "yiddish",
+}
+
+// searchConfigForLocale picks the text search configuration for a locale.
+// Languages Postgres cannot stem are indexed word by word.
+func searchConfigForLocale(locale string) string {
+	language, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(locale)), "-")
+	language, _, _ = strings.Cut(language, "_")
+	if language == "" {
+		return searchDefaultConfig
+	}
+
+	if config, ok := searchConfigs[language]; ok {
+		return config
+	}
+
+	return searchFallbackConfig
+}
+
+type AssignmentSearchResult struct {
+	Assignment     Assignment `json:"assignment"`
+	Rank           float64    `json:"rank"`
+	TitleHighlight string     `json:"title_highlight"`
+	NoteHighlight  *string    `json:"note_highlight"`
+}
+
+func prefixTSQuery(text string) string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, len(words))
+	for i, word := range words {
+		terms[i] = strings.ToLower(word) + ":*"
+	}
+
+	return strings.Join(terms, " & ")
+}
@@ -0,0 +1,31 @@
+package app
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) SearchAssignments(ctx context.Context, userID uuid.UUID, text string, limit int) ([]AssignmentSearchResult, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.SearchAssignments")
+	defer span.End()
+
+	tsQuery := prefixTSQuery(text)
+	if tsQuery == "" {
+		return nil, NewClientError(http.StatusBadRequest, errors.New("search query must contain at least one word"))
+	}
+
+	if limit <= 0 {
+		limit = searchDefaultLimit
+	}
+	limit = min(limit, searchMaxLimit)
+
+	results, err := s.assignmentRepository.SearchAssignments(ctx, userID, tsQuery, int64(limit))
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,29 @@
+package app
+
+import "testing"
+
+func TestSearchConfigForLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{locale: "en", want: "english"},
+		{locale: "en-US", want: "english"},
+		{locale: "id-ID", want: "indonesian"},
+		{locale: "pt_BR", want: "portuguese"},
+		{locale: "DE-at", want: "german"},
+		{locale: "nb-NO", want: "norwegian"},
+		{locale: "zh-Hant-TW", want: "simple"},
+		{locale: "ja", want: "simple"},
+		{locale: "", want: "english"},
+		{locale: "  ", want: "english"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			if got := searchConfigForLocale(tt.locale); got != tt.want {
+				t.Errorf("searchConfigForLocale(%q) = %q, want %q", tt.locale, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,44 +4,120 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"time"
 
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/authservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/go-jet/jet/v2/qrm"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Service struct {
-	tracer               trace.Tracer
-	repository           *Repository
-	assignmentRepository *AssignmentRepository
-	reminderRepository   *ReminderRepository
-	missionServiceClient missionservice.MissionServiceClient
+	tracer                         trace.Tracer
+	repository                     *Repository
+	assignmentRepository           *AssignmentRepository
+	reminderRepository             *ReminderRepository
+	attachmentRepository           *AttachmentRepository
+	calendarFeedRepository         *CalendarFeedRepository
+	collaboratorRepository         *CollaboratorRepository
+	workspaceRepository            *WorkspaceRepository
+	assignmentChangeRepository     *AssignmentChangeRepository
+	statsRepository                *StatsRepository
+	focusSessionRepository         *FocusSessionRepository
+	assignmentTagRepository        *AssignmentTagRepository
+	quietHoursRepository           *QuietHoursRepository
+	assignmentTemplateRepository   *AssignmentTemplateRepository
+	assignmentDependencyRepository *AssignmentDependencyRepository
+	webhookRepository              *WebhookRepository
+	userEventRepository            *UserEventRepository
+	syncRepository                 *SyncRepository
+	missionServiceClient           missionservice.MissionServiceClient
+	authServiceClient              authservice.AuthServiceClient
+	s3Client                       *s3.Client
+	webhookClient                  *http.Client
+	userEventHub                   *UserEventHub
+	attachmentConfig               AttachmentConfig
+	staticServiceEnpoint           string
+	calendarFeedEndpoint           string
 }
 
-func NewService(
-	tracer trace.Tracer,
-	repository *Repository,
-	assignmentRepository *AssignmentRepository,
-	reminderRepository *ReminderRepository,
-	missionServiceClient missionservice.MissionServiceClient,
-) *Service {
+type ServiceDependencies struct {
+	Tracer                         trace.Tracer
+	Repository                     *Repository
+	AssignmentRepository           *AssignmentRepository
+	ReminderRepository             *ReminderRepository
+	AttachmentRepository           *AttachmentRepository
+	CalendarFeedRepository         *CalendarFeedRepository
+	CollaboratorRepository         *CollaboratorRepository
+	WorkspaceRepository            *WorkspaceRepository
+	AssignmentChangeRepository     *AssignmentChangeRepository
+	StatsRepository                *StatsRepository
+	FocusSessionRepository         *FocusSessionRepository
+	AssignmentTagRepository        *AssignmentTagRepository
+	QuietHoursRepository           *QuietHoursRepository
+	AssignmentTemplateRepository   *AssignmentTemplateRepository
+	AssignmentDependencyRepository *AssignmentDependencyRepository
+	WebhookRepository              *WebhookRepository
+	UserEventRepository            *UserEventRepository
+	SyncRepository                 *SyncRepository
+	MissionServiceClient           missionservice.MissionServiceClient
+	AuthServiceClient              authservice.AuthServiceClient
+	S3Client                       *s3.Client
+	WebhookClient                  *http.Client
+	UserEventHub                   *UserEventHub
+	AttachmentConfig               AttachmentConfig
+	StaticServiceEnpoint           string
+	CalendarFeedEndpoint           string
+}
+
+func NewService(dependencies ServiceDependencies) *Service {
 	return &Service{
-		tracer:               tracer,
-		repository:           repository,
-		assignmentRepository: assignmentRepository,
-		reminderRepository:   reminderRepository,
-		missionServiceClient: missionServiceClient,
+		tracer:                         dependencies.Tracer,
+		repository:                     dependencies.Repository,
+		assignmentRepository:           dependencies.AssignmentRepository,
+		reminderRepository:             dependencies.ReminderRepository,
+		attachmentRepository:           dependencies.AttachmentRepository,
+		calendarFeedRepository:         dependencies.CalendarFeedRepository,
+		collaboratorRepository:         dependencies.CollaboratorRepository,
+		workspaceRepository:            dependencies.WorkspaceRepository,
+		assignmentChangeRepository:     dependencies.AssignmentChangeRepository,
+		statsRepository:                dependencies.StatsRepository,
+		focusSessionRepository:         dependencies.FocusSessionRepository,
+		assignmentTagRepository:        dependencies.AssignmentTagRepository,
+		quietHoursRepository:           dependencies.QuietHoursRepository,
+		assignmentTemplateRepository:   dependencies.AssignmentTemplateRepository,
+		assignmentDependencyRepository: dependencies.AssignmentDependencyRepository,
+		webhookRepository:              dependencies.WebhookRepository,
+		userEventRepository:            dependencies.UserEventRepository,
+		syncRepository:                 dependencies.SyncRepository,
+		missionServiceClient:           dependencies.MissionServiceClient,
+		authServiceClient:              dependencies.AuthServiceClient,
+		s3Client:                       dependencies.S3Client,
+		webhookClient:                  dependencies.WebhookClient,
+		userEventHub:                   dependencies.UserEventHub,
+		attachmentConfig:               dependencies.AttachmentConfig,
+		staticServiceEnpoint:           dependencies.StaticServiceEnpoint,
+		calendarFeedEndpoint:           dependencies.CalendarFeedEndpoint,
 	}
 }
 
-func (s *Service) triggerMissionEvent(ctx context.Context, userID uuid.UUID, event missionservice.TriggerMissionEvent) error {
+func (s *Service) triggerMissionEvent(ctx context.Context, userID uuid.UUID, event *missionservice.MissionEvent) error {
 	_, triggerMissionEventSpan := s.tracer.Start(ctx, "Service.triggerMissionEvent")
 	defer triggerMissionEventSpan.End()
 
 	_, err := s.missionServiceClient.TriggerMissionEvent(ctx, &missionservice.TriggerMissionEventRequest{
-		UserId: userID.String(),
-		Event:  event,
+		UserId:     userID.String(),
+		Event:      event.Event,
+		Amount:     event.Amount,
+		EventId:    event.EventId,
+		OccurredAt: event.OccurredAt,
+		SourceId:   event.SourceId,
+		Attributes: event.Attributes,
 	})
 	if err != nil {
 		triggerMissionEventSpan.RecordError(err)
@@ -49,21 +125,131 @@ func (s *Service) triggerMissionEvent(ctx context.Context, userID uuid.UUID, eve
 	return err
 }
 
-func (s *Service) getAuthorizedAssignmentByID(ctx context.Context, userID uuid.UUID, assignmentID int32) (Assignment, ServiceError) {
+func (s *Service) triggerMissionEvents(ctx context.Context, userID uuid.UUID, events []*missionservice.MissionEvent) error {
+	_, triggerMissionEventsSpan := s.tracer.Start(ctx, "Service.triggerMissionEvents")
+	defer triggerMissionEventsSpan.End()
+
+	_, err := s.missionServiceClient.TriggerMissionEvents(ctx, &missionservice.TriggerMissionEventsRequest{
+		UserId:        userID.String(),
+		MissionEvents: events,
+	})
+	if err != nil {
+		triggerMissionEventsSpan.RecordError(err)
+	}
+	return err
+}
+
+func (s *Service) getAuthorizedAssignmentByID(ctx context.Context, userID uuid.UUID, assignmentID int32, required CollaboratorRole) (Assignment, ServiceError) {
 	assignment, err := s.assignmentRepository.FindAssignmentByIDJoinReminders(ctx, assignmentID)
-	if err == sql.ErrNoRows {
-		return Assignment{}, NewClientError(http.StatusNotFound, err)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return Assignment{}, NewClientError(http.StatusNotFound, errors.New("assignment not found"))
 	}
 	if err != nil {
 		return Assignment{}, NewInternalServiceError(err)
 	}
-	if assignment.UserID != userID {
-		return Assignment{}, NewClientError(http.StatusForbidden, errors.New("assignment does not belong to user"))
+
+	if serviceErr := s.authorizeAssignment(ctx, userID, assignment, required); serviceErr != nil {
+		return Assignment{}, serviceErr
 	}
 
 	return assignment, nil
 }
 
+func (s *Service) authorizeAssignment(ctx context.Context, userID uuid.UUID, assignment Assignment, required CollaboratorRole) ServiceError {
+	role, err := s.assignmentRole(ctx, userID, assignment)
+	if err != nil {
+		return NewInternalServiceError(err)
+	}
+	if role == "" {
+		return NewClientError(http.StatusForbidden, errors.New("assignment does not belong to user"))
+	}
+	if !role.allows(required) {
+		return NewClientError(http.StatusForbidden, errors.Errorf("assignment requires %s role", required))
+	}
+
+	return nil
+}
+
+func (s *Service) assignmentRole(ctx context.Context, userID uuid.UUID, assignment Assignment) (CollaboratorRole, error) {
+	if assignment.UserID == userID {
+		return CollaboratorRoleOwner, nil
+	}
+
+	collaborator, err := s.collaboratorRepository.FindCollaboratorByAssignmentIDAndUserID(ctx, assignment.ID, userID)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if collaborator.AcceptedAt == nil {
+		return "", nil
+	}
+
+	return collaborator.Role, nil
+}
+
+func (s *Service) resolveUser(ctx context.Context, email string, userID string) (uuid.UUID, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.resolveUser")
+	defer span.End()
+
+	var (
+		user *authservice.UserResponse
+		err  error
+	)
+	if email != "" {
+		user, err = s.authServiceClient.GetUserByEmail(ctx, &authservice.GetUserByEmailRequest{Email: email})
+	} else {
+		user, err = s.authServiceClient.GetUserByID(ctx, &authservice.GetUserByIDRequest{UserId: userID})
+	}
+	if status.Code(err) == codes.NotFound {
+		return uuid.Nil, NewClientError(http.StatusNotFound, errors.New("user not found"))
+	}
+	if err != nil {
+		span.RecordError(err)
+		return uuid.Nil, NewInternalServiceError(err)
+	}
+
+	id, err := uuid.Parse(user.Id)
+	if err != nil {
+		return uuid.Nil, NewInternalServiceError(err)
+	}
+
+	return id, nil
+}
+
+func (s *Service) userLocation(ctx context.Context, userID uuid.UUID) *time.Location {
+	ctx, span := s.tracer.Start(ctx, "Service.userLocation")
+	defer span.End()
+
+	preferences, err := s.authServiceClient.GetUserPreferences(ctx, &authservice.GetUserByIDRequest{UserId: userID.String()})
+	if err != nil {
+		span.RecordError(err)
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(preferences.Timezone)
+	if err != nil {
+		span.RecordError(err)
+		return time.UTC
+	}
+
+	return location
+}
+
+func (s *Service) userSearchConfig(ctx context.Context, userID uuid.UUID) string {
+	ctx, span := s.tracer.Start(ctx, "Service.userSearchConfig")
+	defer span.End()
+
+	preferences, err := s.authServiceClient.GetUserPreferences(ctx, &authservice.GetUserByIDRequest{UserId: userID.String()})
+	if err != nil {
+		span.RecordError(err)
+		return searchDefaultConfig
+	}
+
+	return searchConfigForLocale(preferences.Locale)
+}
+
 func (s *Service) HealthCheck(ctx context.Context) map[string]map[string]string {
 	ctx, span := s.tracer.Start(ctx, "Service.HealthCheck")
 	defer span.End()
@@ -81,37 +267,63 @@ func (s *Service) CreateAssignment(ctx context.Context, userID uuid.UUID, reques
 
 	assignment, reminders := request.toAssignmentAndReminders(userID)
 
+	reminders, serviceErr := s.scheduleReminders(ctx, userID, assignment.DueDate, reminders)
+	if serviceErr != nil {
+		return Assignment{}, serviceErr
+	}
+
 	tx, err := s.repository.BeginTransaction(ctx)
 	if err != nil {
-		tx.Rollback()
 		return Assignment{}, NewInternalServiceError(err)
 	}
 
-	assignment, err = s.assignmentRepository.InsertAssignmentWithTransaction(ctx, tx, assignment)
+	assignment, err = s.insertAssignmentWithTransaction(ctx, tx, userID, assignment, reminders, nil)
 	if err != nil {
 		tx.Rollback()
 		return Assignment{}, NewInternalServiceError(err)
 	}
+	tx.Commit()
 
-	if reminders == nil {
-		tx.Commit()
-		return assignment, NewInternalServiceError(err)
+	err = s.triggerMissionEvent(ctx, userID, newAssignmentMissionEvent(missionservice.TriggerMissionEvent_MISSION_EVENT_CREATE_ASSIGNMENT, assignment, time.Now()))
+	if err != nil {
+		return Assignment{}, NewInternalServiceError(err)
 	}
 
-	for i := range reminders {
-		reminders[i].AssignmentID = assignment.ID
+	return assignment, nil
+}
+
+func (s *Service) insertAssignmentWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID, assignment Assignment, reminders []Reminder, tags []string) (Assignment, error) {
+	if assignment.SearchConfig == "" {
+		assignment.SearchConfig = s.userSearchConfig(ctx, assignment.UserID)
 	}
 
-	assignment.Reminders, err = s.reminderRepository.InsertRemindersWithTransaction(ctx, tx, reminders)
+	assignment, err := s.assignmentRepository.InsertAssignmentWithTransaction(ctx, tx, assignment)
 	if err != nil {
-		tx.Rollback()
-		return Assignment{}, NewInternalServiceError(err)
+		return Assignment{}, err
+	}
+
+	if len(reminders) > 0 {
+		for i := range reminders {
+			reminders[i].AssignmentID = assignment.ID
+		}
+
+		assignment.Reminders, err = s.reminderRepository.InsertRemindersWithTransaction(ctx, tx, reminders)
+		if err != nil {
+			return Assignment{}, err
+		}
 	}
-	tx.Commit()
 
-	err = s.triggerMissionEvent(ctx, userID, missionservice.TriggerMissionEvent_MISSION_EVENT_CREATE_ASSIGNMENT)
+	err = s.recordAssignmentChangesWithTransaction(ctx, tx, []AssignmentChange{
+		newAssignmentChange(userID, AssignmentChangeActionCreate, nil, assignment),
+	})
 	if err != nil {
-		return Assignment{}, NewInternalServiceError(err)
+		return Assignment{}, err
+	}
+
+	if len(tags) > 0 {
+		if err := s.assignmentTagRepository.ReplaceAssignmentTagsWithTransaction(ctx, tx, assignment.ID, tags); err != nil {
+			return Assignment{}, err
+		}
 	}
 
 	return assignment, nil
@@ -133,7 +345,7 @@ func (s *Service) GetAssignmentByID(ctx context.Context, userID uuid.UUID, assig
 	ctx, span := s.tracer.Start(ctx, "Service.GetAssignmentByID")
 	defer span.End()
 
-	assignment, err := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID)
+	assignment, err := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleViewer)
 	if err != nil {
 		return Assignment{}, err
 	}
@@ -141,34 +353,45 @@ func (s *Service) GetAssignmentByID(ctx context.Context, userID uuid.UUID, assig
 	return assignment, nil
 }
 
-func (s *Service) DeleteAssignmentByID(ctx context.Context, userID uuid.UUID, assignmentID int32) ServiceError {
+func (s *Service) DeleteAssignmentByID(ctx context.Context, userID uuid.UUID, assignmentID int32, ifMatch string) ServiceError {
 	ctx, span := s.tracer.Start(ctx, "Service.DeleteAssignmentByID")
 	defer span.End()
 
-	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID)
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleOwner)
 	if serviceErr != nil {
 		return serviceErr
 	}
 
+	if serviceErr := checkAssignmentPrecondition(assignment, ifMatch); serviceErr != nil {
+		return serviceErr
+	}
+
 	tx, err := s.repository.BeginTransaction(ctx)
 	if err != nil {
 		return NewInternalServiceError(err)
 	}
 
-	err = s.reminderRepository.DeleteRemindersByAssignmentIDWithTransaction(ctx, tx, assignment.ID)
+	deleted, err := s.assignmentRepository.SoftDeleteAssignmentWithTransaction(ctx, tx, assignment)
+	if errors.Is(err, qrm.ErrNoRows) {
+		tx.Rollback()
+		return s.assignmentConflict(ctx, userID, assignment.ID)
+	}
 	if err != nil {
 		tx.Rollback()
 		return NewInternalServiceError(err)
 	}
+	deleted.Reminders = assignment.Reminders
 
-	err = s.assignmentRepository.DeleteAssignmentByIDWithTransaction(ctx, tx, assignmentID)
+	err = s.recordAssignmentChangesWithTransaction(ctx, tx, []AssignmentChange{
+		newAssignmentChange(userID, AssignmentChangeActionDelete, &assignment, deleted),
+	})
 	if err != nil {
 		tx.Rollback()
 		return NewInternalServiceError(err)
 	}
 	tx.Commit()
 
-	err = s.triggerMissionEvent(ctx, userID, missionservice.TriggerMissionEvent_MISSION_EVENT_DELETE_ASSIGNMENT)
+	err = s.triggerMissionEvent(ctx, userID, newAssignmentMissionEvent(missionservice.TriggerMissionEvent_MISSION_EVENT_DELETE_ASSIGNMENT, deleted, time.Now()))
 	if err != nil {
 		return NewInternalServiceError(err)
 	}
@@ -176,29 +399,48 @@ func (s *Service) DeleteAssignmentByID(ctx context.Context, userID uuid.UUID, as
 	return nil
 }
 
-func (s *Service) UpdateAssignmentByID(ctx context.Context, userID uuid.UUID, assignmentID int32, request UpdateAssignmentRequest) (Assignment, ServiceError) {
+func (s *Service) UpdateAssignmentByID(ctx context.Context, userID uuid.UUID, assignmentID int32, ifMatch string, request UpdateAssignmentRequest) (Assignment, ServiceError) {
 	ctx, span := s.tracer.Start(ctx, "Service.UpdateAssignmentByID")
 	defer span.End()
 
-	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID)
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleEditor)
 	if serviceErr != nil {
 		return Assignment{}, serviceErr
 	}
 
+	if serviceErr := checkAssignmentPrecondition(assignment, ifMatch); serviceErr != nil {
+		return Assignment{}, serviceErr
+	}
+
+	before := assignment
+	wasCompleted := assignment.IsCompleted != nil && *assignment.IsCompleted
 	assignmentRequest := request.toAssignment()
 
+	if serviceErr := s.checkAssignmentNotBlocked(ctx, before, assignmentRequest.IsCompleted, false); serviceErr != nil {
+		return Assignment{}, serviceErr
+	}
+
 	assignment.Title = assignmentRequest.Title
 	assignment.Note = assignmentRequest.Note
 	assignment.DueDate = assignmentRequest.DueDate
 	assignment.IsCompleted = assignmentRequest.IsCompleted
 	assignment.IsImportant = assignmentRequest.IsImportant
 
+	assignmentRequest.Reminders, serviceErr = s.scheduleReminders(ctx, assignment.UserID, assignment.DueDate, assignmentRequest.Reminders)
+	if serviceErr != nil {
+		return Assignment{}, serviceErr
+	}
+
 	tx, err := s.repository.BeginTransaction(ctx)
 	if err != nil {
 		return Assignment{}, NewInternalServiceError(err)
 	}
 
 	assignment, err = s.assignmentRepository.UpdateAssignmentWithTransaction(ctx, tx, assignment)
+	if errors.Is(err, qrm.ErrNoRows) {
+		tx.Rollback()
+		return Assignment{}, s.assignmentConflict(ctx, userID, assignmentID)
+	}
 	if err != nil {
 		tx.Rollback()
 		return Assignment{}, NewInternalServiceError(err)
@@ -209,27 +451,59 @@ func (s *Service) UpdateAssignmentByID(ctx context.Context, userID uuid.UUID, as
 		tx.Rollback()
 		return Assignment{}, NewInternalServiceError(err)
 	}
+	assignment.Reminders = nil
+
+	if len(assignmentRequest.Reminders) > 0 {
+		for i := range assignmentRequest.Reminders {
+			assignmentRequest.Reminders[i].AssignmentID = assignment.ID
+		}
+
+		reminders, err := s.reminderRepository.InsertRemindersWithTransaction(ctx, tx, assignmentRequest.Reminders)
+		if err != nil {
+			tx.Rollback()
+			return Assignment{}, NewInternalServiceError(err)
+		}
+		assignment.Reminders = reminders
+	}
 
-	reminders, err := s.reminderRepository.InsertRemindersWithTransaction(ctx, tx, assignmentRequest.Reminders)
+	err = s.recordAssignmentChangesWithTransaction(ctx, tx, []AssignmentChange{
+		newAssignmentChange(userID, AssignmentChangeActionUpdate, &before, assignment),
+	})
 	if err != nil {
 		tx.Rollback()
 		return Assignment{}, NewInternalServiceError(err)
 	}
-	assignment.Reminders = reminders
-	tx.Commit()
+
+	if err := tx.Commit(); err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	isCompleted := assignment.IsCompleted != nil && *assignment.IsCompleted
+	if event, ok := completionMissionEvent(wasCompleted, isCompleted); ok {
+		if err := s.triggerMissionEvent(ctx, userID, newAssignmentMissionEvent(event, assignment, time.Now())); err != nil {
+			return Assignment{}, NewInternalServiceError(err)
+		}
+	}
 
 	return assignment, nil
 }
 
-func (s *Service) ChangeIsCompletedByID(ctx context.Context, userID uuid.UUID, assignmentID int32, isCompleted bool) (Assignment, ServiceError) {
+func (s *Service) ChangeIsCompletedByID(ctx context.Context, userID uuid.UUID, assignmentID int32, isCompleted bool, force bool) (Assignment, ServiceError) {
 	ctx, span := s.tracer.Start(ctx, "Service.ChangeIsCompletedByID")
 	defer span.End()
 
-	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID)
+	assignment, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleEditor)
 	if serviceErr != nil {
 		return Assignment{}, serviceErr
 	}
 
+	before := assignment
+	wasCompleted := assignment.IsCompleted != nil && *assignment.IsCompleted
+
+	if serviceErr := s.checkAssignmentNotBlocked(ctx, before, &isCompleted, force); serviceErr != nil {
+		return Assignment{}, serviceErr
+	}
+
 	tx, err := s.repository.BeginTransaction(ctx)
 	if err != nil {
 		return Assignment{}, NewInternalServiceError(err)
@@ -238,27 +512,53 @@ func (s *Service) ChangeIsCompletedByID(ctx context.Context, userID uuid.UUID, a
 	assignment.IsCompleted = &isCompleted
 
 	assignment, err = s.assignmentRepository.UpdateAssignmentWithTransaction(ctx, tx, assignment)
+	if errors.Is(err, qrm.ErrNoRows) {
+		tx.Rollback()
+		return Assignment{}, s.assignmentConflict(ctx, userID, assignmentID)
+	}
 	if err != nil {
+		tx.Rollback()
 		return Assignment{}, NewInternalServiceError(err)
 	}
+	assignment.Reminders = before.Reminders
 
-	var event missionservice.TriggerMissionEvent
+	err = s.recordAssignmentChangesWithTransaction(ctx, tx, []AssignmentChange{
+		newAssignmentChange(userID, completionChangeAction(isCompleted), &before, assignment),
+	})
+	if err != nil {
+		tx.Rollback()
+		return Assignment{}, NewInternalServiceError(err)
+	}
 
-	if *assignment.IsCompleted != isCompleted && isCompleted {
-		event = missionservice.TriggerMissionEvent_MISSION_EVENT_DONE_ASSIGNMENT
+	if event, ok := completionMissionEvent(wasCompleted, isCompleted); ok {
+		if err := s.triggerMissionEvent(ctx, userID, newAssignmentMissionEvent(event, assignment, time.Now())); err != nil {
+			tx.Rollback()
+			return Assignment{}, NewInternalServiceError(err)
+		}
 	}
 
-	if *assignment.IsCompleted != isCompleted && !isCompleted {
-		event = missionservice.TriggerMissionEvent_MISSION_EVENT_UNDONE_ASSIGNMENT
+	tx.Commit()
+
+	return assignment, nil
+}
 
+func (s *Service) assignmentConflict(ctx context.Context, userID uuid.UUID, assignmentID int32) ServiceError {
+	current, serviceErr := s.getAuthorizedAssignmentByID(ctx, userID, assignmentID, CollaboratorRoleViewer)
+	if serviceErr != nil {
+		return serviceErr
 	}
 
-	if err := s.triggerMissionEvent(ctx, userID, event); err != nil {
-		tx.Rollback()
-		return Assignment{}, NewInternalServiceError(err)
+	return NewClientErrorWithData(http.StatusPreconditionFailed, errors.New("assignment has been modified"), current)
+}
+
+func checkAssignmentPrecondition(assignment Assignment, ifMatch string) ServiceError {
+	if ifMatch == "" {
+		return NewClientError(http.StatusPreconditionRequired, errors.New("If-Match header is required"))
 	}
 
-	tx.Commit()
+	if !ifMatchSatisfied(ifMatch, assignment.ETag()) {
+		return NewClientErrorWithData(http.StatusPreconditionFailed, errors.New("assignment has been modified"), assignment)
+	}
 
-	return assignment, nil
+	return nil
 }
@@ -0,0 +1,67 @@
+package app
+
+import "time"
+
+const (
+	statsDailyBuckets  = 30
+	statsWeeklyBuckets = 12
+)
+
+type StatsBucket struct {
+	Date  string `json:"date"`
+	Count int64  `json:"count"`
+}
+
+type CompletionSummary struct {
+	OnTime               int64    `json:"on_time"`
+	Late                 int64    `json:"late"`
+	Overdue              int64    `json:"overdue"`
+	AverageLeadTimeHours *float64 `json:"average_lead_time_hours"`
+}
+
+type CompletionStreaks struct {
+	Current int64 `json:"current"`
+	Longest int64 `json:"longest"`
+}
+
+type AssignmentStats struct {
+	Timezone             string        `json:"timezone"`
+	CompletedPerDay      []StatsBucket `json:"completed_per_day"`
+	CompletedPerWeek     []StatsBucket `json:"completed_per_week"`
+	OnTimeCount          int64         `json:"on_time_count"`
+	LateCount            int64         `json:"late_count"`
+	OnTimeRate           *float64      `json:"on_time_rate"`
+	CurrentStreak        int64         `json:"current_streak"`
+	LongestStreak        int64         `json:"longest_streak"`
+	OverdueCount         int64         `json:"overdue_count"`
+	AverageLeadTimeHours *float64      `json:"average_lead_time_hours"`
+}
+
+type AssignmentCounts struct {
+	Total     int64 `json:"total"`
+	Completed int64 `json:"completed"`
+	Pending   int64 `json:"pending"`
+	Overdue   int64 `json:"overdue"`
+	Important int64 `json:"important"`
+}
+
+func countAssignments(assignments []Assignment, now time.Time) AssignmentCounts {
+	counts := AssignmentCounts{Total: int64(len(assignments))}
+	for _, assignment := range assignments {
+		if assignment.IsImportant != nil && *assignment.IsImportant {
+			counts.Important++
+		}
+
+		if assignment.IsCompleted != nil && *assignment.IsCompleted {
+			counts.Completed++
+			continue
+		}
+
+		counts.Pending++
+		if assignment.DueDate != nil && assignment.DueDate.Before(now) {
+			counts.Overdue++
+		}
+	}
+
+	return counts
+}
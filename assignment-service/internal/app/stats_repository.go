@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+type StatsRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewStatsRepository(db *database.Service, tracer trace.Tracer) *StatsRepository {
+	return &StatsRepository{db, tracer}
+}
+
+func (r *StatsRepository) FindCompletedBuckets(ctx context.Context, userID uuid.UUID, timezone string, unit string, buckets int) ([]StatsBucket, error) {
+	ctx, span := r.tracer.Start(ctx, "StatsRepository.FindCompletedBuckets")
+	defer span.End()
+
+	query := RawStatement(`
+		WITH bounds AS (
+			SELECT date_trunc(#unit, now() AT TIME ZONE #timezone) AS current_bucket
+		)
+		SELECT
+			to_char(bucket, 'YYYY-MM-DD') AS "stats_bucket.date",
+			COUNT(assignments.id) AS "stats_bucket.count"
+		FROM bounds
+		CROSS JOIN generate_series(
+			bounds.current_bucket - (#buckets::int - 1) * ('1 ' || #unit)::interval,
+			bounds.current_bucket,
+			('1 ' || #unit)::interval
+		) AS bucket
+		LEFT JOIN assignments
+			ON assignments.user_id = #userID
+			AND assignments.deleted_at IS NULL
+			AND assignments.completed_at IS NOT NULL
+			AND date_trunc(#unit, assignments.completed_at AT TIME ZONE #timezone) = bucket
+		GROUP BY bucket
+		ORDER BY bucket
+	`, RawArgs{
+		"#userID":   userID,
+		"#timezone": timezone,
+		"#unit":     unit,
+		"#buckets":  buckets,
+	})
+
+	result := []StatsBucket{}
+	err := query.QueryContext(ctx, r.db.Pool, &result)
+
+	return result, err
+}
+
+func (r *StatsRepository) FindCompletionSummary(ctx context.Context, userID uuid.UUID) (CompletionSummary, error) {
+	ctx, span := r.tracer.Start(ctx, "StatsRepository.FindCompletionSummary")
+	defer span.End()
+
+	query := RawStatement(`
+		SELECT
+			COUNT(*) FILTER (WHERE completed_at IS NOT NULL AND due_date IS NOT NULL AND completed_at <= due_date) AS "completion_summary.on_time",
+			COUNT(*) FILTER (WHERE completed_at IS NOT NULL AND due_date IS NOT NULL AND completed_at > due_date) AS "completion_summary.late",
+			COUNT(*) FILTER (WHERE is_completed IS NOT TRUE AND due_date < now()) AS "completion_summary.overdue",
+			(AVG(EXTRACT(EPOCH FROM due_date - created_at)) FILTER (WHERE due_date IS NOT NULL) / 3600)::float8 AS "completion_summary.average_lead_time_hours"
+		FROM assignments
+		WHERE user_id = #userID AND deleted_at IS NULL
+	`, RawArgs{
+		"#userID": userID,
+	})
+
+	var summary CompletionSummary
+	err := query.QueryContext(ctx, r.db.Pool, &summary)
+
+	return summary, err
+}
+
+func (r *StatsRepository) FindCompletionStreaks(ctx context.Context, userID uuid.UUID, timezone string) (CompletionStreaks, error) {
+	ctx, span := r.tracer.Start(ctx, "StatsRepository.FindCompletionStreaks")
+	defer span.End()
+
+	query := RawStatement(`
+		WITH days AS (
+			SELECT DISTINCT (completed_at AT TIME ZONE #timezone)::date AS day
+			FROM assignments
+			WHERE user_id = #userID AND deleted_at IS NULL AND completed_at IS NOT NULL
+		), streaks AS (
+			SELECT MAX(day) AS last_day, COUNT(*) AS length
+			FROM (SELECT day, day - (ROW_NUMBER() OVER (ORDER BY day))::int AS island FROM days) AS islands
+			GROUP BY island
+		)
+		SELECT
+			COALESCE(MAX(length) FILTER (WHERE last_day >= (now() AT TIME ZONE #timezone)::date - 1), 0) AS "completion_streaks.current",
+			COALESCE(MAX(length), 0) AS "completion_streaks.longest"
+		FROM streaks
+	`, RawArgs{
+		"#userID":   userID,
+		"#timezone": timezone,
+	})
+
+	var streaks CompletionStreaks
+	err := query.QueryContext(ctx, r.db.Pool, &streaks)
+
+	return streaks, err
+}
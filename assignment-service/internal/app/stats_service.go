@@ -0,0 +1,69 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (s *Service) GetAssignmentStats(ctx context.Context, userID uuid.UUID, location *time.Location) (AssignmentStats, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetAssignmentStats")
+	defer span.End()
+
+	if location == nil {
+		location = s.userLocation(ctx, userID)
+	}
+	timezone := location.String()
+
+	perDay, err := s.statsRepository.FindCompletedBuckets(ctx, userID, timezone, "day", statsDailyBuckets)
+	if err != nil {
+		return AssignmentStats{}, NewInternalServiceError(err)
+	}
+
+	perWeek, err := s.statsRepository.FindCompletedBuckets(ctx, userID, timezone, "week", statsWeeklyBuckets)
+	if err != nil {
+		return AssignmentStats{}, NewInternalServiceError(err)
+	}
+
+	summary, err := s.statsRepository.FindCompletionSummary(ctx, userID)
+	if err != nil {
+		return AssignmentStats{}, NewInternalServiceError(err)
+	}
+
+	streaks, err := s.statsRepository.FindCompletionStreaks(ctx, userID, timezone)
+	if err != nil {
+		return AssignmentStats{}, NewInternalServiceError(err)
+	}
+
+	stats := AssignmentStats{
+		Timezone:             timezone,
+		CompletedPerDay:      perDay,
+		CompletedPerWeek:     perWeek,
+		OnTimeCount:          summary.OnTime,
+		LateCount:            summary.Late,
+		CurrentStreak:        streaks.Current,
+		LongestStreak:        streaks.Longest,
+		OverdueCount:         summary.Overdue,
+		AverageLeadTimeHours: summary.AverageLeadTimeHours,
+	}
+
+	if rated := summary.OnTime + summary.Late; rated > 0 {
+		rate := float64(summary.OnTime) / float64(rated)
+		stats.OnTimeRate = &rate
+	}
+
+	return stats, nil
+}
+
+func (s *Service) CountAssignments(ctx context.Context, userID uuid.UUID) (AssignmentCounts, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.CountAssignments")
+	defer span.End()
+
+	assignments, serviceErr := s.GetAssignments(ctx, userID)
+	if serviceErr != nil {
+		return AssignmentCounts{}, serviceErr
+	}
+
+	return countAssignments(assignments, time.Now()), nil
+}
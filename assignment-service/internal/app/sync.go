@@ -0,0 +1,157 @@
+package app
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const syncTokenPrefix = "v1:"
+
+var errInvalidSyncToken = errors.New("invalid sync token")
+
+type SyncEntity string
+
+const (
+	SyncEntityAssignment SyncEntity = "assignment"
+	SyncEntityReminder   SyncEntity = "reminder"
+)
+
+type SyncChange struct {
+	ID            int64      `json:"id" sql:"primary_key"`
+	TransactionID int64      `json:"transaction_id"`
+	Entity        SyncEntity `json:"entity"`
+	EntityID      int32      `json:"entity_id"`
+	AssignmentID  int32      `json:"assignment_id"`
+	UserID        uuid.UUID  `json:"user_id"`
+	CreatedAt     *time.Time `json:"created_at"`
+}
+
+type syncSnapshot struct {
+	Xmin int64 `alias:"sync_snapshot.xmin"`
+}
+
+func encodeSyncToken(xmin int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(syncTokenPrefix + strconv.FormatInt(xmin, 10)))
+}
+
+func parseSyncToken(token string) (int64, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errInvalidSyncToken
+	}
+
+	value, ok := strings.CutPrefix(string(decoded), syncTokenPrefix)
+	if !ok {
+		return 0, errInvalidSyncToken
+	}
+
+	xmin, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || xmin < 0 {
+		return 0, errInvalidSyncToken
+	}
+
+	return xmin, nil
+}
+
+type SyncAssignments struct {
+	Upserted []Assignment `json:"upserted"`
+	Deleted  []int32      `json:"deleted"`
+}
+
+type SyncReminders struct {
+	Upserted []Reminder `json:"upserted"`
+	Deleted  []int32    `json:"deleted"`
+}
+
+type SyncResult struct {
+	Token       string          `json:"token"`
+	Full        bool            `json:"full"`
+	Assignments SyncAssignments `json:"assignments"`
+	Reminders   SyncReminders   `json:"reminders"`
+}
+
+type SyncUploadAction string
+
+const (
+	SyncUploadActionCreate SyncUploadAction = "create"
+	SyncUploadActionUpdate SyncUploadAction = "update"
+	SyncUploadActionDelete SyncUploadAction = "delete"
+)
+
+type SyncUploadChange struct {
+	ClientID   string                   `json:"client_id" validate:"required,max=64"`
+	Action     SyncUploadAction         `json:"action" validate:"required,oneof=create update delete"`
+	ID         int32                    `json:"id" validate:"required_unless=Action create"`
+	Version    int32                    `json:"version" validate:"required_unless=Action create"`
+	Assignment *UpdateAssignmentRequest `json:"assignment" validate:"required_unless=Action delete"`
+}
+
+func (c SyncUploadChange) ifMatch() string {
+	return Assignment{ID: c.ID, Version: c.Version}.ETag()
+}
+
+func (c SyncUploadChange) createRequest() CreateAssignmentRequest {
+	return CreateAssignmentRequest{
+		Title:       c.Assignment.Title,
+		Note:        c.Assignment.Note,
+		DueDate:     c.Assignment.DueDate,
+		IsImportant: c.Assignment.IsImportant,
+		Reminders:   c.Assignment.Reminders,
+	}
+}
+
+type SyncUploadRequest struct {
+	Changes []SyncUploadChange `json:"changes" validate:"required,min=1,max=100,unique=ClientID,dive"`
+}
+
+type SyncUploadStatus string
+
+const (
+	SyncUploadStatusApplied   SyncUploadStatus = "applied"
+	SyncUploadStatusConflict  SyncUploadStatus = "conflict"
+	SyncUploadStatusNotFound  SyncUploadStatus = "not_found"
+	SyncUploadStatusForbidden SyncUploadStatus = "forbidden"
+	SyncUploadStatusRejected  SyncUploadStatus = "rejected"
+)
+
+type SyncUploadResult struct {
+	ClientID   string           `json:"client_id"`
+	Status     SyncUploadStatus `json:"status"`
+	Assignment *Assignment      `json:"assignment,omitempty"`
+	Error      string           `json:"error,omitempty"`
+}
+
+type SyncUploadResponse struct {
+	Applied   int                `json:"applied"`
+	Conflicts int                `json:"conflicts"`
+	Failed    int                `json:"failed"`
+	Results   []SyncUploadResult `json:"results"`
+}
+
+// syncUploadFailure maps a rejected upload change to its per-item status.
+// Conflicts carry the server's current assignment so the client can merge.
+func syncUploadFailure(clientID string, serviceErr ServiceError) SyncUploadResult {
+	result := SyncUploadResult{ClientID: clientID, Error: serviceErr.Error()}
+
+	switch serviceErr.Code() {
+	case http.StatusPreconditionFailed:
+		result.Status = SyncUploadStatusConflict
+		if current, ok := serviceErr.Data().(Assignment); ok {
+			result.Assignment = &current
+		}
+	case http.StatusNotFound:
+		result.Status = SyncUploadStatusNotFound
+	case http.StatusForbidden:
+		result.Status = SyncUploadStatusForbidden
+	default:
+		result.Status = SyncUploadStatusRejected
+	}
+
+	return result
+}
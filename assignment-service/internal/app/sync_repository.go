@@ -0,0 +1,59 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var SyncChangeTable = table.SyncChanges.AS("sync_change")
+
+type SyncRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewSyncRepository(db *database.Service, tracer trace.Tracer) *SyncRepository {
+	return &SyncRepository{db, tracer}
+}
+
+func (r *SyncRepository) FindSnapshotXminWithTransaction(ctx context.Context, tx *sql.Tx) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "SyncRepository.FindSnapshotXminWithTransaction")
+	defer span.End()
+
+	query := RawStatement(`SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint AS "sync_snapshot.xmin"`)
+
+	var snapshot syncSnapshot
+	err := query.QueryContext(ctx, tx, &snapshot)
+
+	return snapshot.Xmin, err
+}
+
+func (r *SyncRepository) FindSyncChangesWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID, since int64) ([]SyncChange, error) {
+	ctx, span := r.tracer.Start(ctx, "SyncRepository.FindSyncChangesWithTransaction")
+	defer span.End()
+
+	sharedAssignmentIDs := CollaboratorTable.SELECT(CollaboratorTable.AssignmentID).
+		FROM(CollaboratorTable).
+		WHERE(CollaboratorTable.UserID.EQ(UUID(userID)).AND(CollaboratorTable.AcceptedAt.IS_NOT_NULL()))
+
+	query := SyncChangeTable.SELECT(SyncChangeTable.AllColumns).
+		FROM(SyncChangeTable).
+		WHERE(
+			SyncChangeTable.TransactionID.GT_EQ(Int64(since)).
+				AND(SyncChangeTable.UserID.EQ(UUID(userID)).OR(SyncChangeTable.AssignmentID.IN(sharedAssignmentIDs))),
+		).
+		ORDER_BY(SyncChangeTable.ID.ASC())
+
+	changes := []SyncChange{}
+	err := query.QueryContext(ctx, tx, &changes)
+
+	return changes, err
+}
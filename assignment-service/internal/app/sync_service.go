@@ -0,0 +1,157 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+func (s *Service) SyncChanges(ctx context.Context, userID uuid.UUID, token string) (SyncResult, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.SyncChanges")
+	defer span.End()
+
+	var since int64
+	if token != "" {
+		var err error
+		since, err = parseSyncToken(token)
+		if err != nil {
+			return SyncResult{}, NewClientErrorWithData(http.StatusBadRequest, err, map[string]string{
+				"Since": "The Since field must be a token returned by a previous sync",
+			})
+		}
+	}
+
+	tx, err := s.repository.BeginSnapshotTransaction(ctx)
+	if err != nil {
+		return SyncResult{}, NewInternalServiceError(err)
+	}
+	defer tx.Rollback()
+
+	xmin, err := s.syncRepository.FindSnapshotXminWithTransaction(ctx, tx)
+	if err != nil {
+		return SyncResult{}, NewInternalServiceError(err)
+	}
+
+	result := SyncResult{
+		Token:       encodeSyncToken(xmin),
+		Full:        token == "",
+		Assignments: SyncAssignments{Upserted: []Assignment{}, Deleted: []int32{}},
+		Reminders:   SyncReminders{Upserted: []Reminder{}, Deleted: []int32{}},
+	}
+
+	if result.Full {
+		result.Assignments.Upserted, err = s.assignmentRepository.FindAssignmentsByUserIDJoinRemindersWithTransaction(ctx, tx, userID)
+		if err != nil {
+			return SyncResult{}, NewInternalServiceError(err)
+		}
+
+		for _, assignment := range result.Assignments.Upserted {
+			result.Reminders.Upserted = append(result.Reminders.Upserted, assignment.Reminders...)
+		}
+
+		return result, nil
+	}
+
+	changes, err := s.syncRepository.FindSyncChangesWithTransaction(ctx, tx, userID, since)
+	if err != nil {
+		return SyncResult{}, NewInternalServiceError(err)
+	}
+
+	assignmentIDs := []int32{}
+	reminderIDs := []int32{}
+	for _, change := range changes {
+		switch {
+		case change.Entity == SyncEntityAssignment && !slices.Contains(assignmentIDs, change.EntityID):
+			assignmentIDs = append(assignmentIDs, change.EntityID)
+		case change.Entity == SyncEntityReminder && !slices.Contains(reminderIDs, change.EntityID):
+			reminderIDs = append(reminderIDs, change.EntityID)
+		}
+	}
+
+	if len(assignmentIDs) > 0 {
+		assignments, err := s.assignmentRepository.FindVisibleAssignmentsByIDsJoinRemindersWithTransaction(ctx, tx, userID, assignmentIDs)
+		if err != nil {
+			return SyncResult{}, NewInternalServiceError(err)
+		}
+
+		result.Assignments.Upserted = assignments
+		for _, id := range assignmentIDs {
+			if !slices.ContainsFunc(assignments, func(assignment Assignment) bool { return assignment.ID == id }) {
+				result.Assignments.Deleted = append(result.Assignments.Deleted, id)
+			}
+		}
+	}
+
+	if len(reminderIDs) > 0 {
+		reminders, err := s.reminderRepository.FindVisibleRemindersByIDsWithTransaction(ctx, tx, userID, reminderIDs)
+		if err != nil {
+			return SyncResult{}, NewInternalServiceError(err)
+		}
+
+		result.Reminders.Upserted = reminders
+		for _, id := range reminderIDs {
+			if !slices.ContainsFunc(reminders, func(reminder Reminder) bool { return reminder.ID == id }) {
+				result.Reminders.Deleted = append(result.Reminders.Deleted, id)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) UploadSyncChanges(ctx context.Context, userID uuid.UUID, request SyncUploadRequest) (SyncUploadResponse, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.UploadSyncChanges")
+	defer span.End()
+
+	response := SyncUploadResponse{
+		Results: make([]SyncUploadResult, len(request.Changes)),
+	}
+
+	for i, change := range request.Changes {
+		result, serviceErr := s.applySyncUploadChange(ctx, userID, change)
+		if serviceErr != nil && serviceErr.Code() == http.StatusInternalServerError {
+			return SyncUploadResponse{}, serviceErr
+		}
+
+		response.Results[i] = result
+		switch result.Status {
+		case SyncUploadStatusApplied:
+			response.Applied++
+		case SyncUploadStatusConflict:
+			response.Conflicts++
+		default:
+			response.Failed++
+		}
+	}
+
+	return response, nil
+}
+
+func (s *Service) applySyncUploadChange(ctx context.Context, userID uuid.UUID, change SyncUploadChange) (SyncUploadResult, ServiceError) {
+	result := SyncUploadResult{ClientID: change.ClientID, Status: SyncUploadStatusApplied}
+
+	var (
+		assignment Assignment
+		serviceErr ServiceError
+	)
+	switch change.Action {
+	case SyncUploadActionCreate:
+		assignment, serviceErr = s.CreateAssignment(ctx, userID, change.createRequest())
+	case SyncUploadActionUpdate:
+		assignment, serviceErr = s.UpdateAssignmentByID(ctx, userID, change.ID, change.ifMatch(), *change.Assignment)
+	case SyncUploadActionDelete:
+		serviceErr = s.DeleteAssignmentByID(ctx, userID, change.ID, change.ifMatch())
+	}
+
+	if serviceErr != nil {
+		return syncUploadFailure(change.ClientID, serviceErr), serviceErr
+	}
+
+	if change.Action != SyncUploadActionDelete {
+		result.Assignment = &assignment
+	}
+
+	return result, nil
+}
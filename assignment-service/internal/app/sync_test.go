@@ -0,0 +1,152 @@
+package app
+
+import (
+	"encoding/base64"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestSyncTokenRoundTrip(t *testing.T) {
+	for _, xmin := range []int64{0, 1, 4242, math.MaxInt64} {
+		token := encodeSyncToken(xmin)
+
+		got, err := parseSyncToken(token)
+		if err != nil {
+			t.Fatalf("parseSyncToken(%q) error = %v", token, err)
+		}
+		if got != xmin {
+			t.Errorf("parseSyncToken(encodeSyncToken(%d)) = %d", xmin, got)
+		}
+	}
+}
+
+func TestParseSyncTokenRejectsInvalidTokens(t *testing.T) {
+	encode := func(value string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(value))
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "empty", token: ""},
+		{name: "not base64", token: "not a token!"},
+		{name: "padded base64", token: base64.URLEncoding.EncodeToString([]byte("v1:42"))},
+		{name: "missing prefix", token: encode("42")},
+		{name: "unknown version", token: encode("v2:42")},
+		{name: "empty value", token: encode("v1:")},
+		{name: "negative", token: encode("v1:-1")},
+		{name: "not a number", token: encode("v1:abc")},
+		{name: "trailing data", token: encode("v1:42 ")},
+		{name: "overflow", token: encode("v1:9223372036854775808")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseSyncToken(tt.token); !errors.Is(err, errInvalidSyncToken) {
+				t.Errorf("parseSyncToken(%q) error = %v, want %v", tt.token, err, errInvalidSyncToken)
+			}
+		})
+	}
+}
+
+func TestSyncUploadConflictDetection(t *testing.T) {
+	current := Assignment{ID: 7, Title: "Essay", Version: 4}
+
+	tests := []struct {
+		name     string
+		version  int32
+		conflict bool
+	}{
+		{name: "client has the current version", version: 4, conflict: false},
+		{name: "client edited a stale version", version: 3, conflict: true},
+		{name: "client is ahead of the server", version: 5, conflict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change := SyncUploadChange{ClientID: "c1", Action: SyncUploadActionUpdate, ID: current.ID, Version: tt.version}
+
+			serviceErr := checkAssignmentPrecondition(current, change.ifMatch())
+			if conflict := serviceErr != nil; conflict != tt.conflict {
+				t.Fatalf("checkAssignmentPrecondition() = %v, want conflict %v", serviceErr, tt.conflict)
+			}
+			if !tt.conflict {
+				return
+			}
+
+			result := syncUploadFailure(change.ClientID, serviceErr)
+			if result.Status != SyncUploadStatusConflict {
+				t.Errorf("status = %s, want %s", result.Status, SyncUploadStatusConflict)
+			}
+			if result.Assignment == nil || result.Assignment.Version != current.Version {
+				t.Errorf("assignment = %+v, want the server's version %d", result.Assignment, current.Version)
+			}
+		})
+	}
+}
+
+func TestSyncUploadFailure(t *testing.T) {
+	current := Assignment{ID: 7, Version: 4}
+
+	tests := []struct {
+		name           string
+		serviceErr     ServiceError
+		status         SyncUploadStatus
+		withAssignment bool
+	}{
+		{
+			name:           "conflict with current assignment",
+			serviceErr:     NewClientErrorWithData(http.StatusPreconditionFailed, errors.New("assignment has been modified"), current),
+			status:         SyncUploadStatusConflict,
+			withAssignment: true,
+		},
+		{
+			name:       "conflict without data",
+			serviceErr: NewClientError(http.StatusPreconditionFailed, errors.New("assignment has been modified")),
+			status:     SyncUploadStatusConflict,
+		},
+		{
+			name:       "not found",
+			serviceErr: NewClientError(http.StatusNotFound, errors.New("assignment not found")),
+			status:     SyncUploadStatusNotFound,
+		},
+		{
+			name:       "forbidden",
+			serviceErr: NewClientError(http.StatusForbidden, errors.New("assignment requires editor role")),
+			status:     SyncUploadStatusForbidden,
+		},
+		{
+			name:       "blocked",
+			serviceErr: NewClientError(http.StatusConflict, errors.New("assignment is blocked by incomplete prerequisites")),
+			status:     SyncUploadStatusRejected,
+		},
+		{
+			name:       "invalid reminders",
+			serviceErr: NewClientError(http.StatusBadRequest, errRelativeReminderWithoutDueDate),
+			status:     SyncUploadStatusRejected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := syncUploadFailure("c1", tt.serviceErr)
+
+			if result.ClientID != "c1" {
+				t.Errorf("client_id = %q, want c1", result.ClientID)
+			}
+			if result.Status != tt.status {
+				t.Errorf("status = %s, want %s", result.Status, tt.status)
+			}
+			if result.Error == "" {
+				t.Error("error message is empty")
+			}
+			if (result.Assignment != nil) != tt.withAssignment {
+				t.Errorf("assignment = %+v, want present %v", result.Assignment, tt.withAssignment)
+			}
+		})
+	}
+}
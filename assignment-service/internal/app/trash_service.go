@@ -0,0 +1,123 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const trashPurgeBatchSize = 500
+
+func (s *Service) GetTrashedAssignments(ctx context.Context, userID uuid.UUID) ([]Assignment, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetTrashedAssignments")
+	defer span.End()
+
+	assignments, err := s.assignmentRepository.FindTrashedAssignmentsByUserIDJoinReminders(ctx, userID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return assignments, nil
+}
+
+func (s *Service) RestoreAssignmentByID(ctx context.Context, userID uuid.UUID, assignmentID int32) (Assignment, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.RestoreAssignmentByID")
+	defer span.End()
+
+	assignment, err := s.assignmentRepository.FindTrashedAssignmentByID(ctx, assignmentID)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return Assignment{}, NewClientError(http.StatusNotFound, errors.New("assignment is not in trash"))
+	}
+	if err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+	if assignment.UserID != userID {
+		return Assignment{}, NewClientError(http.StatusForbidden, errors.New("assignment does not belong to user"))
+	}
+
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if err := s.restoreAssignmentWithTransaction(ctx, tx, userID, assignment, AssignmentChangeActionRestore); err != nil {
+		tx.Rollback()
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	err = s.triggerMissionEvent(ctx, userID, newAssignmentMissionEvent(missionservice.TriggerMissionEvent_MISSION_EVENT_CREATE_ASSIGNMENT, assignment, time.Now()))
+	if err != nil {
+		return Assignment{}, NewInternalServiceError(err)
+	}
+
+	return s.getAuthorizedAssignmentByID(ctx, userID, assignment.ID, CollaboratorRoleOwner)
+}
+
+func (s *Service) restoreAssignmentWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID, trashed Assignment, action AssignmentChangeAction) error {
+	restored, err := s.assignmentRepository.RestoreAssignmentByIDWithTransaction(ctx, tx, trashed.ID)
+	if err != nil {
+		return err
+	}
+	restored.Reminders = trashed.Reminders
+
+	return s.recordAssignmentChangesWithTransaction(ctx, tx, []AssignmentChange{
+		newAssignmentChange(userID, action, &trashed, restored),
+	})
+}
+
+func (s *Service) PurgeTrash(ctx context.Context, retention time.Duration) (int, error) {
+	ctx, span := s.tracer.Start(ctx, "Service.PurgeTrash")
+	defer span.End()
+
+	purged := 0
+	for {
+		ids, err := s.assignmentRepository.FindAssignmentIDsTrashedBefore(ctx, time.Now().Add(-retention), trashPurgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+		if len(ids) == 0 {
+			return purged, nil
+		}
+
+		tx, err := s.repository.BeginTransaction(ctx)
+		if err != nil {
+			return purged, err
+		}
+
+		attachments, err := s.deleteAssignmentsWithTransaction(ctx, tx, ids)
+		if err != nil {
+			tx.Rollback()
+			return purged, err
+		}
+
+		if err := tx.Commit(); err != nil {
+			return purged, err
+		}
+
+		s.deleteAttachmentObjects(ctx, attachments)
+		purged += len(ids)
+	}
+}
+
+func (s *Service) deleteAssignmentsWithTransaction(ctx context.Context, tx *sql.Tx, assignmentIDs []int32) ([]Attachment, error) {
+	if err := s.reminderRepository.DeleteRemindersByAssignmentIDsWithTransaction(ctx, tx, assignmentIDs); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.attachmentRepository.DeleteAttachmentsByAssignmentIDsWithTransaction(ctx, tx, assignmentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachments, s.assignmentRepository.DeleteAssignmentsByIDsWithTransaction(ctx, tx, assignmentIDs)
+}
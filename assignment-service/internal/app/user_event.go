@@ -0,0 +1,130 @@
+package app
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+const (
+	userEventChannel        = "user_events"
+	userEventMaxPayloadSize = 7900
+	userEventBufferSize     = 32
+)
+
+type UserEvent struct {
+	UserID uuid.UUID      `json:"user_id"`
+	Event  WebhookEvent   `json:"event"`
+	Data   WebhookPayload `json:"data"`
+}
+
+func (e UserEvent) notification() (string, error) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+
+	if len(payload) > userEventMaxPayloadSize {
+		e.Data = nil
+		payload, err = json.Marshal(e)
+	}
+
+	return string(payload), err
+}
+
+func userEventRecipients(ownerID uuid.UUID, actorID uuid.UUID) []uuid.UUID {
+	if ownerID == uuid.Nil || ownerID == actorID {
+		return []uuid.UUID{actorID}
+	}
+
+	return []uuid.UUID{ownerID, actorID}
+}
+
+type UserEventHub struct {
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[chan UserEvent]struct{}
+	closed      bool
+}
+
+func NewUserEventHub() *UserEventHub {
+	return &UserEventHub{
+		subscribers: make(map[uuid.UUID]map[chan UserEvent]struct{}),
+	}
+}
+
+func (h *UserEventHub) subscribe(userID uuid.UUID) (<-chan UserEvent, func()) {
+	events := make(chan UserEvent, userEventBufferSize)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		close(events)
+		return events, func() {}
+	}
+
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[chan UserEvent]struct{})
+	}
+	h.subscribers[userID][events] = struct{}{}
+
+	return events, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		h.remove(userID, events)
+	}
+}
+
+func (h *UserEventHub) broadcast(event UserEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for events := range h.subscribers[event.UserID] {
+		select {
+		case events <- event:
+		default:
+			h.remove(event.UserID, events)
+		}
+	}
+}
+
+func (h *UserEventHub) disconnectAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.removeAll()
+}
+
+func (h *UserEventHub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	h.removeAll()
+}
+
+func (h *UserEventHub) removeAll() {
+	for userID, subscribers := range h.subscribers {
+		for events := range subscribers {
+			h.remove(userID, events)
+		}
+	}
+}
+
+func (h *UserEventHub) remove(userID uuid.UUID, events chan UserEvent) {
+	subscribers, ok := h.subscribers[userID]
+	if !ok {
+		return
+	}
+	if _, ok := subscribers[events]; !ok {
+		return
+	}
+
+	delete(subscribers, events)
+	if len(subscribers) == 0 {
+		delete(h.subscribers, userID)
+	}
+	close(events)
+}
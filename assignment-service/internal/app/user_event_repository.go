@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+type UserEventRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewUserEventRepository(db *database.Service, tracer trace.Tracer) *UserEventRepository {
+	return &UserEventRepository{db, tracer}
+}
+
+func (r *UserEventRepository) NotifyUserEvents(ctx context.Context, events []UserEvent) error {
+	ctx, span := r.tracer.Start(ctx, "UserEventRepository.NotifyUserEvents")
+	defer span.End()
+
+	for _, event := range events {
+		query, err := notifyUserEventStatement(event)
+		if err != nil {
+			return err
+		}
+
+		if _, err := query.ExecContext(ctx, r.db.Pool); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *UserEventRepository) NotifyUserEventsWithTransaction(ctx context.Context, tx *sql.Tx, events []UserEvent) error {
+	ctx, span := r.tracer.Start(ctx, "UserEventRepository.NotifyUserEventsWithTransaction")
+	defer span.End()
+
+	for _, event := range events {
+		query, err := notifyUserEventStatement(event)
+		if err != nil {
+			return err
+		}
+
+		if _, err := query.ExecContext(ctx, tx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *UserEventRepository) ListenUserEvents(ctx context.Context, handle func(event UserEvent)) error {
+	return r.db.Listen(ctx, userEventChannel, func(payload string) {
+		var event UserEvent
+		if err := json.Unmarshal([]byte(payload), &event); err != nil {
+			return
+		}
+
+		handle(event)
+	})
+}
+
+func notifyUserEventStatement(event UserEvent) (Statement, error) {
+	payload, err := event.notification()
+	if err != nil {
+		return nil, err
+	}
+
+	return RawStatement("SELECT pg_notify(#channel, #payload)", RawArgs{
+		"#channel": userEventChannel,
+		"#payload": payload,
+	}), nil
+}
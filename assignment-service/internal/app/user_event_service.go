@@ -0,0 +1,23 @@
+package app
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func (s *Service) SubscribeUserEvents(userID uuid.UUID) (<-chan UserEvent, func()) {
+	return s.userEventHub.subscribe(userID)
+}
+
+func (s *Service) ListenUserEvents(ctx context.Context) error {
+	return s.userEventRepository.ListenUserEvents(ctx, s.userEventHub.broadcast)
+}
+
+func (s *Service) DisconnectUserEventSubscribers() {
+	s.userEventHub.disconnectAll()
+}
+
+func (s *Service) CloseUserEventSubscribers() {
+	s.userEventHub.close()
+}
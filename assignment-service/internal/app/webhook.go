@@ -0,0 +1,254 @@
+package app
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type WebhookEvent string
+
+const (
+	WebhookEventAssignmentCreated   WebhookEvent = "assignment.created"
+	WebhookEventAssignmentUpdated   WebhookEvent = "assignment.updated"
+	WebhookEventAssignmentCompleted WebhookEvent = "assignment.completed"
+	WebhookEventAssignmentDeleted   WebhookEvent = "assignment.deleted"
+	WebhookEventAssignmentRestored  WebhookEvent = "assignment.restored"
+	WebhookEventReminderFired       WebhookEvent = "reminder.fired"
+	WebhookEventMissionCompleted    WebhookEvent = "mission.completed"
+	WebhookEventMissionClaimed      WebhookEvent = "mission.claimed"
+	WebhookEventMissionProgress     WebhookEvent = "mission.progress"
+	WebhookEventCoinsUpdated        WebhookEvent = "coins.updated"
+)
+
+var externalWebhookEvents = []WebhookEvent{
+	WebhookEventMissionCompleted,
+	WebhookEventMissionClaimed,
+	WebhookEventMissionProgress,
+	WebhookEventCoinsUpdated,
+}
+
+func webhookEventForChange(action AssignmentChangeAction) WebhookEvent {
+	switch action {
+	case AssignmentChangeActionCreate:
+		return WebhookEventAssignmentCreated
+	case AssignmentChangeActionComplete:
+		return WebhookEventAssignmentCompleted
+	case AssignmentChangeActionDelete:
+		return WebhookEventAssignmentDeleted
+	case AssignmentChangeActionRestore:
+		return WebhookEventAssignmentRestored
+	default:
+		return WebhookEventAssignmentUpdated
+	}
+}
+
+type WebhookEvents []WebhookEvent
+
+func (e *WebhookEvents) Scan(value any) error {
+	return scanJSONColumn(value, e)
+}
+
+func (e WebhookEvents) subscribes(event WebhookEvent) bool {
+	return slices.Contains(e, event)
+}
+
+type Webhook struct {
+	ID        int32         `json:"id" sql:"primary_key"`
+	UserID    uuid.UUID     `json:"user_id"`
+	URL       string        `json:"url"`
+	Secret    string        `json:"secret,omitempty"`
+	Events    WebhookEvents `json:"events"`
+	IsActive  bool          `json:"is_active"`
+	CreatedAt *time.Time    `json:"created_at"`
+	UpdatedAt *time.Time    `json:"updated_at"`
+}
+
+type WebhookRequest struct {
+	URL      string         `json:"url" validate:"required,http_url,max=2048"`
+	Events   []WebhookEvent `json:"events" validate:"required,min=1,max=20,dive,oneof=assignment.created assignment.updated assignment.completed assignment.deleted assignment.restored reminder.fired mission.completed mission.claimed mission.progress coins.updated"`
+	IsActive *bool          `json:"is_active"`
+}
+
+func (r WebhookRequest) toWebhook(userID uuid.UUID) Webhook {
+	events := WebhookEvents{}
+	for _, event := range r.Events {
+		if !events.subscribes(event) {
+			events = append(events, event)
+		}
+	}
+
+	isActive := r.IsActive == nil || *r.IsActive
+
+	return Webhook{
+		UserID:   userID,
+		URL:      r.URL,
+		Events:   events,
+		IsActive: isActive,
+	}
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+const (
+	webhookMaxAttempts     = 8
+	webhookBaseRetryDelay  = 30 * time.Second
+	webhookMaxRetryDelay   = 6 * time.Hour
+	webhookDeliveryLease   = time.Minute
+	webhookDeliveryTimeout = 10 * time.Second
+	webhookMaxErrorLength  = 1000
+	webhookMaxDrainLength  = 4 << 10
+)
+
+type WebhookPayload []byte
+
+func (p *WebhookPayload) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		*p = append(WebhookPayload(nil), v...)
+	case string:
+		*p = WebhookPayload(v)
+	case nil:
+		*p = nil
+	default:
+		return errors.Errorf("cannot scan %T into WebhookPayload", value)
+	}
+
+	return nil
+}
+
+func (p WebhookPayload) MarshalJSON() ([]byte, error) {
+	if len(p) == 0 {
+		return []byte("null"), nil
+	}
+
+	return p, nil
+}
+
+func (p *WebhookPayload) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*p = nil
+		return nil
+	}
+
+	*p = append(WebhookPayload(nil), data...)
+	return nil
+}
+
+type WebhookDelivery struct {
+	ID             int32                 `json:"id" sql:"primary_key"`
+	WebhookID      int32                 `json:"webhook_id"`
+	Event          WebhookEvent          `json:"event"`
+	Payload        WebhookPayload        `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int32                 `json:"attempts"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at"`
+	ResponseStatus *int32                `json:"response_status"`
+	Error          *string               `json:"error"`
+	DeliveredAt    *time.Time            `json:"delivered_at"`
+	CreatedAt      *time.Time            `json:"created_at"`
+	UpdatedAt      *time.Time            `json:"updated_at"`
+}
+
+func newWebhookDelivery(webhookID int32, event WebhookEvent, payload WebhookPayload) WebhookDelivery {
+	return WebhookDelivery{
+		WebhookID: webhookID,
+		Event:     event,
+		Payload:   payload,
+		Status:    WebhookDeliveryStatusPending,
+	}
+}
+
+func (d *WebhookDelivery) succeed(responseStatus int, now time.Time) {
+	status := int32(responseStatus)
+
+	d.Attempts++
+	d.Status = WebhookDeliveryStatusSucceeded
+	d.ResponseStatus = &status
+	d.Error = nil
+	d.DeliveredAt = &now
+}
+
+func (d *WebhookDelivery) fail(responseStatus int, message string, now time.Time) {
+	if len(message) > webhookMaxErrorLength {
+		message = message[:webhookMaxErrorLength]
+	}
+
+	d.Attempts++
+	d.Error = &message
+	d.ResponseStatus = nil
+	if responseStatus != 0 {
+		status := int32(responseStatus)
+		d.ResponseStatus = &status
+	}
+
+	if d.Attempts >= webhookMaxAttempts {
+		d.Status = WebhookDeliveryStatusFailed
+		return
+	}
+
+	d.NextAttemptAt = now.Add(webhookRetryDelay(d.Attempts))
+}
+
+func webhookRetryDelay(attempts int32) time.Duration {
+	delay := webhookBaseRetryDelay
+	for i := int32(1); i < attempts && delay < webhookMaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, webhookMaxRetryDelay)
+}
+
+type webhookEnvelope struct {
+	ID        int32          `json:"id"`
+	Event     WebhookEvent   `json:"event"`
+	CreatedAt *time.Time     `json:"created_at"`
+	Data      WebhookPayload `json:"data"`
+}
+
+func (d WebhookDelivery) body() ([]byte, error) {
+	return json.Marshal(webhookEnvelope{
+		ID:        d.ID,
+		Event:     d.Event,
+		CreatedAt: d.CreatedAt,
+		Data:      d.Payload,
+	})
+}
+
+func signWebhookPayload(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type assignmentWebhookPayload struct {
+	AssignmentID int32                  `json:"assignment_id"`
+	ActorID      uuid.UUID              `json:"actor_id"`
+	Action       AssignmentChangeAction `json:"action"`
+	Changes      AssignmentChangeDiff   `json:"changes"`
+	Version      int32                  `json:"version"`
+}
+
+type reminderWebhookPayload struct {
+	ReminderID   int32      `json:"reminder_id"`
+	AssignmentID int32      `json:"assignment_id"`
+	Title        string     `json:"title"`
+	DueDate      *time.Time `json:"due_date"`
+	Date         time.Time  `json:"date"`
+}
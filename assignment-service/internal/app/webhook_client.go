@@ -0,0 +1,116 @@
+package app
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	errWebhookDestinationNotAllowed = errors.New("webhook destination is not allowed")
+	errWebhookSchemeNotAllowed      = errors.New("webhook URL must use http or https")
+)
+
+var webhookBlockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+var webhookBlockedHostSuffixes = []string{
+	".localhost",
+	".local",
+	".internal",
+	".svc",
+}
+
+func NewWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookDeliveryTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !webhookAddressAllowed(addrPort.Addr()) {
+				return errWebhookDestinationNotAllowed
+			}
+
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: webhookDeliveryTimeout,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: webhookDeliveryTimeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func webhookAddressAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() {
+		return false
+	}
+
+	for _, prefix := range webhookBlockedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errWebhookSchemeNotAllowed
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" || host == "localhost" || (!strings.Contains(host, ".") && net.ParseIP(host) == nil) {
+		return errWebhookDestinationNotAllowed
+	}
+
+	for _, suffix := range webhookBlockedHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return errWebhookDestinationNotAllowed
+		}
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil && !webhookAddressAllowed(addr) {
+		return errWebhookDestinationNotAllowed
+	}
+
+	return nil
+}
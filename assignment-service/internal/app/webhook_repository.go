@@ -0,0 +1,288 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var (
+	WebhookTable         = table.Webhooks.AS("webhook")
+	WebhookDeliveryTable = table.WebhookDeliveries.AS("webhook_delivery")
+)
+
+type WebhookRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewWebhookRepository(db *database.Service, tracer trace.Tracer) *WebhookRepository {
+	return &WebhookRepository{db, tracer}
+}
+
+func (r *WebhookRepository) InsertWebhook(ctx context.Context, webhook Webhook) (Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.InsertWebhook")
+	defer span.End()
+
+	events, err := json.Marshal(webhook.Events)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	query := WebhookTable.INSERT(
+		WebhookTable.UserID,
+		WebhookTable.URL,
+		WebhookTable.Secret,
+		WebhookTable.Events,
+		WebhookTable.IsActive,
+	).
+		VALUES(webhook.UserID, webhook.URL, webhook.Secret, Json(events), webhook.IsActive).
+		RETURNING(WebhookTable.AllColumns)
+
+	var inserted Webhook
+	err = query.QueryContext(ctx, r.db.Pool, &inserted)
+
+	return inserted, err
+}
+
+func (r *WebhookRepository) FindWebhookByID(ctx context.Context, id int32) (Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.FindWebhookByID")
+	defer span.End()
+
+	query := WebhookTable.SELECT(WebhookTable.AllColumns).
+		FROM(WebhookTable).
+		WHERE(WebhookTable.ID.EQ(Int32(id)))
+
+	var webhook Webhook
+	err := query.QueryContext(ctx, r.db.Pool, &webhook)
+
+	return webhook, err
+}
+
+func (r *WebhookRepository) FindWebhooksByIDs(ctx context.Context, ids []int32) ([]Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.FindWebhooksByIDs")
+	defer span.End()
+
+	query := WebhookTable.SELECT(WebhookTable.AllColumns).
+		FROM(WebhookTable).
+		WHERE(WebhookTable.ID.IN(int32Expressions(ids)...))
+
+	webhooks := []Webhook{}
+	err := query.QueryContext(ctx, r.db.Pool, &webhooks)
+
+	return webhooks, err
+}
+
+func (r *WebhookRepository) FindWebhooksByUserID(ctx context.Context, userID uuid.UUID) ([]Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.FindWebhooksByUserID")
+	defer span.End()
+
+	query := WebhookTable.SELECT(WebhookTable.AllColumns).
+		FROM(WebhookTable).
+		WHERE(WebhookTable.UserID.EQ(UUID(userID))).
+		ORDER_BY(WebhookTable.ID.ASC())
+
+	webhooks := []Webhook{}
+	err := query.QueryContext(ctx, r.db.Pool, &webhooks)
+
+	return webhooks, err
+}
+
+func (r *WebhookRepository) FindActiveWebhooksByUserID(ctx context.Context, userID uuid.UUID) ([]Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.FindActiveWebhooksByUserID")
+	defer span.End()
+
+	query := WebhookTable.SELECT(WebhookTable.AllColumns).
+		FROM(WebhookTable).
+		WHERE(WebhookTable.UserID.EQ(UUID(userID)).AND(WebhookTable.IsActive.IS_TRUE()))
+
+	webhooks := []Webhook{}
+	err := query.QueryContext(ctx, r.db.Pool, &webhooks)
+
+	return webhooks, err
+}
+
+func (r *WebhookRepository) FindActiveWebhooksByUserIDsWithTransaction(ctx context.Context, tx *sql.Tx, userIDs []uuid.UUID) ([]Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.FindActiveWebhooksByUserIDsWithTransaction")
+	defer span.End()
+
+	query := WebhookTable.SELECT(WebhookTable.AllColumns).
+		FROM(WebhookTable).
+		WHERE(WebhookTable.UserID.IN(uuidExpressions(userIDs)...).AND(WebhookTable.IsActive.IS_TRUE()))
+
+	webhooks := []Webhook{}
+	err := query.QueryContext(ctx, tx, &webhooks)
+
+	return webhooks, err
+}
+
+func (r *WebhookRepository) UpdateWebhook(ctx context.Context, webhook Webhook) (Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.UpdateWebhook")
+	defer span.End()
+
+	events, err := json.Marshal(webhook.Events)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	query := WebhookTable.UPDATE(
+		WebhookTable.URL,
+		WebhookTable.Events,
+		WebhookTable.IsActive,
+		WebhookTable.UpdatedAt,
+	).
+		SET(webhook.URL, Json(events), webhook.IsActive, time.Now()).
+		WHERE(WebhookTable.ID.EQ(Int32(webhook.ID))).
+		RETURNING(WebhookTable.AllColumns)
+
+	var updated Webhook
+	err = query.QueryContext(ctx, r.db.Pool, &updated)
+
+	return updated, err
+}
+
+func (r *WebhookRepository) DeleteWebhookByID(ctx context.Context, id int32) error {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.DeleteWebhookByID")
+	defer span.End()
+
+	query := WebhookTable.DELETE().WHERE(WebhookTable.ID.EQ(Int32(id)))
+
+	_, err := query.ExecContext(ctx, r.db.Pool)
+
+	return err
+}
+
+func (r *WebhookRepository) InsertWebhookDeliveries(ctx context.Context, deliveries []WebhookDelivery) error {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.InsertWebhookDeliveries")
+	defer span.End()
+
+	query := WebhookDeliveryTable.INSERT(
+		WebhookDeliveryTable.WebhookID,
+		WebhookDeliveryTable.Event,
+		WebhookDeliveryTable.Payload,
+		WebhookDeliveryTable.Status,
+	)
+
+	for _, delivery := range deliveries {
+		query = query.VALUES(delivery.WebhookID, string(delivery.Event), Json(string(delivery.Payload)), string(delivery.Status))
+	}
+
+	_, err := query.ExecContext(ctx, r.db.Pool)
+
+	return err
+}
+
+func (r *WebhookRepository) InsertWebhookDeliveriesWithTransaction(ctx context.Context, tx *sql.Tx, deliveries []WebhookDelivery) error {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.InsertWebhookDeliveriesWithTransaction")
+	defer span.End()
+
+	query := WebhookDeliveryTable.INSERT(
+		WebhookDeliveryTable.WebhookID,
+		WebhookDeliveryTable.Event,
+		WebhookDeliveryTable.Payload,
+		WebhookDeliveryTable.Status,
+	)
+
+	for _, delivery := range deliveries {
+		query = query.VALUES(delivery.WebhookID, string(delivery.Event), Json(string(delivery.Payload)), string(delivery.Status))
+	}
+
+	_, err := query.ExecContext(ctx, tx)
+
+	return err
+}
+
+func (r *WebhookRepository) FindWebhookDeliveryByID(ctx context.Context, id int32) (WebhookDelivery, error) {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.FindWebhookDeliveryByID")
+	defer span.End()
+
+	query := WebhookDeliveryTable.SELECT(WebhookDeliveryTable.AllColumns).
+		FROM(WebhookDeliveryTable).
+		WHERE(WebhookDeliveryTable.ID.EQ(Int32(id)))
+
+	var delivery WebhookDelivery
+	err := query.QueryContext(ctx, r.db.Pool, &delivery)
+
+	return delivery, err
+}
+
+func (r *WebhookRepository) FindWebhookDeliveriesByWebhookID(ctx context.Context, webhookID int32, limit int64) ([]WebhookDelivery, error) {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.FindWebhookDeliveriesByWebhookID")
+	defer span.End()
+
+	query := WebhookDeliveryTable.SELECT(WebhookDeliveryTable.AllColumns).
+		FROM(WebhookDeliveryTable).
+		WHERE(WebhookDeliveryTable.WebhookID.EQ(Int32(webhookID))).
+		ORDER_BY(WebhookDeliveryTable.ID.DESC()).
+		LIMIT(limit)
+
+	deliveries := []WebhookDelivery{}
+	err := query.QueryContext(ctx, r.db.Pool, &deliveries)
+
+	return deliveries, err
+}
+
+func (r *WebhookRepository) ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, limit int64) ([]WebhookDelivery, error) {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.ClaimDueWebhookDeliveries")
+	defer span.End()
+
+	due := table.WebhookDeliveries.SELECT(table.WebhookDeliveries.ID).
+		FROM(table.WebhookDeliveries).
+		WHERE(
+			table.WebhookDeliveries.Status.EQ(String(string(WebhookDeliveryStatusPending))).
+				AND(table.WebhookDeliveries.NextAttemptAt.LT_EQ(TimestampzT(now))),
+		).
+		ORDER_BY(table.WebhookDeliveries.NextAttemptAt.ASC()).
+		LIMIT(limit).
+		FOR(UPDATE().SKIP_LOCKED())
+
+	query := WebhookDeliveryTable.UPDATE(WebhookDeliveryTable.NextAttemptAt, WebhookDeliveryTable.UpdatedAt).
+		SET(now.Add(webhookDeliveryLease), now).
+		WHERE(WebhookDeliveryTable.ID.IN(due)).
+		RETURNING(WebhookDeliveryTable.AllColumns)
+
+	deliveries := []WebhookDelivery{}
+	err := query.QueryContext(ctx, r.db.Pool, &deliveries)
+
+	return deliveries, err
+}
+
+func (r *WebhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery WebhookDelivery) (WebhookDelivery, error) {
+	ctx, span := r.tracer.Start(ctx, "WebhookRepository.UpdateWebhookDelivery")
+	defer span.End()
+
+	query := WebhookDeliveryTable.UPDATE(
+		WebhookDeliveryTable.Status,
+		WebhookDeliveryTable.Attempts,
+		WebhookDeliveryTable.NextAttemptAt,
+		WebhookDeliveryTable.ResponseStatus,
+		WebhookDeliveryTable.Error,
+		WebhookDeliveryTable.DeliveredAt,
+		WebhookDeliveryTable.UpdatedAt,
+	).
+		SET(
+			string(delivery.Status),
+			delivery.Attempts,
+			delivery.NextAttemptAt,
+			delivery.ResponseStatus,
+			delivery.Error,
+			delivery.DeliveredAt,
+			time.Now(),
+		).
+		WHERE(WebhookDeliveryTable.ID.EQ(Int32(delivery.ID))).
+		RETURNING(WebhookDeliveryTable.AllColumns)
+
+	var updated WebhookDelivery
+	err := query.QueryContext(ctx, r.db.Pool, &updated)
+
+	return updated, err
+}
@@ -0,0 +1,517 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	webhookSecretLength       = 32
+	webhookDeliveryLogLimit   = 100
+	webhookDispatchBatchSize  = 50
+	webhookReminderFireWindow = 24 * time.Hour
+)
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func checkWebhookURL(rawURL string) ServiceError {
+	err := validateWebhookURL(rawURL)
+	if errors.Is(err, errWebhookSchemeNotAllowed) {
+		return NewClientErrorWithData(http.StatusUnprocessableEntity, err, map[string]string{
+			"URL": "The URL field must use http or https",
+		})
+	}
+	if err != nil {
+		return NewClientErrorWithData(http.StatusUnprocessableEntity, err, map[string]string{
+			"URL": "The URL field must point to a public host",
+		})
+	}
+
+	return nil
+}
+
+func (s *Service) getAuthorizedWebhookByID(ctx context.Context, userID uuid.UUID, webhookID int32) (Webhook, ServiceError) {
+	webhook, err := s.webhookRepository.FindWebhookByID(ctx, webhookID)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return Webhook{}, NewClientError(http.StatusNotFound, errors.New("webhook not found"))
+	}
+	if err != nil {
+		return Webhook{}, NewInternalServiceError(err)
+	}
+
+	if webhook.UserID != userID {
+		return Webhook{}, NewClientError(http.StatusNotFound, errors.New("webhook not found"))
+	}
+
+	return webhook, nil
+}
+
+func (s *Service) CreateWebhook(ctx context.Context, userID uuid.UUID, request WebhookRequest) (Webhook, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.CreateWebhook")
+	defer span.End()
+
+	if serviceErr := checkWebhookURL(request.URL); serviceErr != nil {
+		return Webhook{}, serviceErr
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return Webhook{}, NewInternalServiceError(err)
+	}
+
+	webhook := request.toWebhook(userID)
+	webhook.Secret = secret
+
+	webhook, err = s.webhookRepository.InsertWebhook(ctx, webhook)
+	if err != nil {
+		return Webhook{}, NewInternalServiceError(err)
+	}
+
+	return webhook, nil
+}
+
+func (s *Service) GetWebhooks(ctx context.Context, userID uuid.UUID) ([]Webhook, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetWebhooks")
+	defer span.End()
+
+	webhooks, err := s.webhookRepository.FindWebhooksByUserID(ctx, userID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	for i := range webhooks {
+		webhooks[i].Secret = ""
+	}
+
+	return webhooks, nil
+}
+
+func (s *Service) GetWebhookByID(ctx context.Context, userID uuid.UUID, webhookID int32) (Webhook, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetWebhookByID")
+	defer span.End()
+
+	webhook, serviceErr := s.getAuthorizedWebhookByID(ctx, userID, webhookID)
+	if serviceErr != nil {
+		return Webhook{}, serviceErr
+	}
+
+	webhook.Secret = ""
+
+	return webhook, nil
+}
+
+func (s *Service) UpdateWebhookByID(ctx context.Context, userID uuid.UUID, webhookID int32, request WebhookRequest) (Webhook, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.UpdateWebhookByID")
+	defer span.End()
+
+	if serviceErr := checkWebhookURL(request.URL); serviceErr != nil {
+		return Webhook{}, serviceErr
+	}
+
+	webhook, serviceErr := s.getAuthorizedWebhookByID(ctx, userID, webhookID)
+	if serviceErr != nil {
+		return Webhook{}, serviceErr
+	}
+
+	updated := request.toWebhook(userID)
+	updated.ID = webhook.ID
+
+	updated, err := s.webhookRepository.UpdateWebhook(ctx, updated)
+	if err != nil {
+		return Webhook{}, NewInternalServiceError(err)
+	}
+
+	updated.Secret = ""
+
+	return updated, nil
+}
+
+func (s *Service) DeleteWebhookByID(ctx context.Context, userID uuid.UUID, webhookID int32) ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.DeleteWebhookByID")
+	defer span.End()
+
+	webhook, serviceErr := s.getAuthorizedWebhookByID(ctx, userID, webhookID)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	if err := s.webhookRepository.DeleteWebhookByID(ctx, webhook.ID); err != nil {
+		return NewInternalServiceError(err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetWebhookDeliveries(ctx context.Context, userID uuid.UUID, webhookID int32) ([]WebhookDelivery, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetWebhookDeliveries")
+	defer span.End()
+
+	webhook, serviceErr := s.getAuthorizedWebhookByID(ctx, userID, webhookID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	deliveries, err := s.webhookRepository.FindWebhookDeliveriesByWebhookID(ctx, webhook.ID, webhookDeliveryLogLimit)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return deliveries, nil
+}
+
+func (s *Service) RedeliverWebhookDelivery(ctx context.Context, userID uuid.UUID, webhookID int32, deliveryID int32) (WebhookDelivery, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.RedeliverWebhookDelivery")
+	defer span.End()
+
+	webhook, serviceErr := s.getAuthorizedWebhookByID(ctx, userID, webhookID)
+	if serviceErr != nil {
+		return WebhookDelivery{}, serviceErr
+	}
+
+	delivery, err := s.webhookRepository.FindWebhookDeliveryByID(ctx, deliveryID)
+	if errors.Is(err, qrm.ErrNoRows) || (err == nil && delivery.WebhookID != webhook.ID) {
+		return WebhookDelivery{}, NewClientError(http.StatusNotFound, errors.New("webhook delivery not found"))
+	}
+	if err != nil {
+		return WebhookDelivery{}, NewInternalServiceError(err)
+	}
+
+	if delivery.Status == WebhookDeliveryStatusPending {
+		return WebhookDelivery{}, NewClientError(http.StatusConflict, errors.New("webhook delivery is still pending"))
+	}
+
+	delivery.Status = WebhookDeliveryStatusPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+
+	delivery, err = s.webhookRepository.UpdateWebhookDelivery(ctx, delivery)
+	if err != nil {
+		return WebhookDelivery{}, NewInternalServiceError(err)
+	}
+
+	return delivery, nil
+}
+
+func (s *Service) PublishUserEvent(ctx context.Context, userID uuid.UUID, event WebhookEvent, payload []byte) ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.PublishUserEvent")
+	defer span.End()
+
+	if !slices.Contains(externalWebhookEvents, event) {
+		return NewClientErrorWithData(http.StatusUnprocessableEntity, errors.Errorf("unsupported event %q", event), map[string]string{
+			"Event": "The Event field must be a mission or coins event",
+		})
+	}
+	if !json.Valid(payload) {
+		return NewClientErrorWithData(http.StatusUnprocessableEntity, errors.New("payload is not valid JSON"), map[string]string{
+			"Payload": "The Payload field must be valid JSON",
+		})
+	}
+
+	err := s.userEventRepository.NotifyUserEvents(ctx, []UserEvent{{UserID: userID, Event: event, Data: payload}})
+	if err != nil {
+		return NewInternalServiceError(err)
+	}
+
+	webhooks, err := s.webhookRepository.FindActiveWebhooksByUserID(ctx, userID)
+	if err != nil {
+		return NewInternalServiceError(err)
+	}
+
+	deliveries := []WebhookDelivery{}
+	for _, webhook := range webhooks {
+		if webhook.Events.subscribes(event) {
+			deliveries = append(deliveries, newWebhookDelivery(webhook.ID, event, payload))
+		}
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err := s.webhookRepository.InsertWebhookDeliveries(ctx, deliveries); err != nil {
+		return NewInternalServiceError(err)
+	}
+
+	return nil
+}
+
+func (s *Service) publishAssignmentEventsWithTransaction(ctx context.Context, tx *sql.Tx, changes []AssignmentChange) error {
+	assignmentIDs := make([]int32, 0, len(changes))
+	for _, change := range changes {
+		if !slices.Contains(assignmentIDs, change.AssignmentID) {
+			assignmentIDs = append(assignmentIDs, change.AssignmentID)
+		}
+	}
+	if len(assignmentIDs) == 0 {
+		return nil
+	}
+
+	owners, err := s.assignmentRepository.FindAssignmentOwnersByIDsWithTransaction(ctx, tx, assignmentIDs)
+	if err != nil {
+		return err
+	}
+
+	ownerIDs := make(map[int32]uuid.UUID, len(owners))
+	userIDs := make([]uuid.UUID, 0, len(owners))
+	for _, owner := range owners {
+		ownerIDs[owner.ID] = owner.UserID
+		if !slices.Contains(userIDs, owner.UserID) {
+			userIDs = append(userIDs, owner.UserID)
+		}
+	}
+
+	webhooks := []Webhook{}
+	if len(userIDs) > 0 {
+		webhooks, err = s.webhookRepository.FindActiveWebhooksByUserIDsWithTransaction(ctx, tx, userIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	events := []UserEvent{}
+	deliveries := []WebhookDelivery{}
+	for _, change := range changes {
+		event := webhookEventForChange(change.Action)
+		ownerID := ownerIDs[change.AssignmentID]
+
+		payload, err := json.Marshal(assignmentWebhookPayload{
+			AssignmentID: change.AssignmentID,
+			ActorID:      change.UserID,
+			Action:       change.Action,
+			Changes:      change.Changes,
+			Version:      change.Version,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, recipient := range userEventRecipients(ownerID, change.UserID) {
+			events = append(events, UserEvent{UserID: recipient, Event: event, Data: payload})
+		}
+
+		for _, webhook := range webhooks {
+			if webhook.UserID == ownerID && webhook.Events.subscribes(event) {
+				deliveries = append(deliveries, newWebhookDelivery(webhook.ID, event, payload))
+			}
+		}
+	}
+
+	if len(deliveries) > 0 {
+		if err := s.webhookRepository.InsertWebhookDeliveriesWithTransaction(ctx, tx, deliveries); err != nil {
+			return err
+		}
+	}
+
+	return s.userEventRepository.NotifyUserEventsWithTransaction(ctx, tx, events)
+}
+
+func (s *Service) FireDueReminders(ctx context.Context) (int, error) {
+	ctx, span := s.tracer.Start(ctx, "Service.FireDueReminders")
+	defer span.End()
+
+	now := time.Now()
+
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	reminders, err := s.reminderRepository.FireDueRemindersWithTransaction(ctx, tx, now.Add(-webhookReminderFireWindow), now)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if len(reminders) == 0 {
+		return 0, tx.Commit()
+	}
+
+	assignmentIDs := make([]int32, 0, len(reminders))
+	for _, reminder := range reminders {
+		if !slices.Contains(assignmentIDs, reminder.AssignmentID) {
+			assignmentIDs = append(assignmentIDs, reminder.AssignmentID)
+		}
+	}
+
+	assignments, err := s.assignmentRepository.FindAssignmentsByIDs(ctx, assignmentIDs)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	assignmentsByID := make(map[int32]Assignment, len(assignments))
+	userIDs := make([]uuid.UUID, 0, len(assignments))
+	for _, assignment := range assignments {
+		assignmentsByID[assignment.ID] = assignment
+		if !slices.Contains(userIDs, assignment.UserID) {
+			userIDs = append(userIDs, assignment.UserID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return len(reminders), tx.Commit()
+	}
+
+	webhooks, err := s.webhookRepository.FindActiveWebhooksByUserIDsWithTransaction(ctx, tx, userIDs)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	events := []UserEvent{}
+	deliveries := []WebhookDelivery{}
+	for _, reminder := range reminders {
+		assignment, ok := assignmentsByID[reminder.AssignmentID]
+		if !ok {
+			continue
+		}
+
+		payload, err := json.Marshal(reminderWebhookPayload{
+			ReminderID:   reminder.ID,
+			AssignmentID: reminder.AssignmentID,
+			Title:        assignment.Title,
+			DueDate:      assignment.DueDate,
+			Date:         reminder.Date,
+		})
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+
+		events = append(events, UserEvent{UserID: assignment.UserID, Event: WebhookEventReminderFired, Data: payload})
+
+		for _, webhook := range webhooks {
+			if webhook.UserID == assignment.UserID && webhook.Events.subscribes(WebhookEventReminderFired) {
+				deliveries = append(deliveries, newWebhookDelivery(webhook.ID, WebhookEventReminderFired, payload))
+			}
+		}
+	}
+
+	if len(deliveries) > 0 {
+		if err := s.webhookRepository.InsertWebhookDeliveriesWithTransaction(ctx, tx, deliveries); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
+	if err := s.userEventRepository.NotifyUserEventsWithTransaction(ctx, tx, events); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	return len(reminders), tx.Commit()
+}
+
+func (s *Service) DispatchWebhookDeliveries(ctx context.Context) (int, error) {
+	ctx, span := s.tracer.Start(ctx, "Service.DispatchWebhookDeliveries")
+	defer span.End()
+
+	deliveries, err := s.webhookRepository.ClaimDueWebhookDeliveries(ctx, time.Now(), webhookDispatchBatchSize)
+	if err != nil || len(deliveries) == 0 {
+		return 0, err
+	}
+
+	webhookIDs := make([]int32, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		if !slices.Contains(webhookIDs, delivery.WebhookID) {
+			webhookIDs = append(webhookIDs, delivery.WebhookID)
+		}
+	}
+
+	webhooks, err := s.webhookRepository.FindWebhooksByIDs(ctx, webhookIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	webhooksByID := make(map[int32]Webhook, len(webhooks))
+	for _, webhook := range webhooks {
+		webhooksByID[webhook.ID] = webhook
+	}
+
+	var dispatchErr error
+	for _, delivery := range deliveries {
+		webhook, ok := webhooksByID[delivery.WebhookID]
+		if !ok {
+			continue
+		}
+
+		if webhook.IsActive {
+			s.deliverWebhook(ctx, webhook, &delivery)
+		} else {
+			delivery.Status = WebhookDeliveryStatusFailed
+			message := "webhook is disabled"
+			delivery.Error = &message
+		}
+
+		if _, err := s.webhookRepository.UpdateWebhookDelivery(ctx, delivery); err != nil && dispatchErr == nil {
+			dispatchErr = err
+		}
+	}
+
+	return len(deliveries), dispatchErr
+}
+
+func (s *Service) deliverWebhook(ctx context.Context, webhook Webhook, delivery *WebhookDelivery) {
+	ctx, span := s.tracer.Start(ctx, "Service.deliverWebhook")
+	defer span.End()
+
+	now := time.Now()
+
+	body, err := delivery.body()
+	if err != nil {
+		delivery.fail(0, err.Error(), now)
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		delivery.fail(0, err.Error(), now)
+		return
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", strconv.Itoa(int(delivery.ID)))
+	req.Header.Set("X-Webhook-Event", string(delivery.Event))
+	req.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set("X-Webhook-Signature", signWebhookPayload(webhook.Secret, now, body))
+
+	res, err := s.webhookClient.Do(req)
+	if errors.Is(err, errWebhookDestinationNotAllowed) {
+		span.RecordError(err)
+		delivery.fail(0, errWebhookDestinationNotAllowed.Error(), now)
+		return
+	}
+	if err != nil {
+		span.RecordError(err)
+		delivery.fail(0, err.Error(), now)
+		return
+	}
+	defer res.Body.Close()
+
+	io.Copy(io.Discard, io.LimitReader(res.Body, webhookMaxDrainLength))
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		delivery.succeed(res.StatusCode, now)
+		return
+	}
+
+	delivery.fail(res.StatusCode, fmt.Sprintf("unexpected status %d", res.StatusCode), now)
+}
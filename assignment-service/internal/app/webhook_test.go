@@ -0,0 +1,269 @@
+package app
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type recordedWebhookRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newWebhookTestServer(t *testing.T, status int, responseBody string) (*httptest.Server, chan recordedWebhookRequest) {
+	t.Helper()
+
+	requests := make(chan recordedWebhookRequest, webhookMaxAttempts)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- recordedWebhookRequest{header: r.Header.Clone(), body: body}
+		w.WriteHeader(status)
+		io.WriteString(w, responseBody)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
+
+func newWebhookTestService(client *http.Client) *Service {
+	return &Service{
+		tracer:        noop.NewTracerProvider().Tracer("test"),
+		webhookClient: client,
+	}
+}
+
+func newWebhookTestDelivery() WebhookDelivery {
+	createdAt := time.Date(2026, time.March, 7, 10, 0, 0, 0, time.UTC)
+
+	delivery := newWebhookDelivery(1, WebhookEventAssignmentCreated, WebhookPayload(`{"assignment_id":42}`))
+	delivery.ID = 7
+	delivery.CreatedAt = &createdAt
+
+	return delivery
+}
+
+func TestDeliverWebhookSignsRequest(t *testing.T) {
+	server, requests := newWebhookTestServer(t, http.StatusNoContent, "")
+	service := newWebhookTestService(server.Client())
+
+	webhook := Webhook{ID: 1, URL: server.URL, Secret: "topsecret", IsActive: true}
+	delivery := newWebhookTestDelivery()
+
+	before := time.Now()
+	service.deliverWebhook(context.Background(), webhook, &delivery)
+	after := time.Now()
+
+	request := <-requests
+
+	if got := request.header.Get("X-Webhook-Id"); got != "7" {
+		t.Errorf("X-Webhook-Id = %q, want %q", got, "7")
+	}
+	if got := request.header.Get("X-Webhook-Event"); got != string(WebhookEventAssignmentCreated) {
+		t.Errorf("X-Webhook-Event = %q, want %q", got, WebhookEventAssignmentCreated)
+	}
+	if got := request.header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+
+	timestamp, err := strconv.ParseInt(request.header.Get("X-Webhook-Timestamp"), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid X-Webhook-Timestamp: %v", err)
+	}
+	if timestamp < before.Unix() || timestamp > after.Unix() {
+		t.Errorf("X-Webhook-Timestamp = %d, want between %d and %d", timestamp, before.Unix(), after.Unix())
+	}
+
+	mac := hmac.New(sha256.New, []byte(webhook.Secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(request.body)
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if got := request.header.Get("X-Webhook-Signature"); got != want {
+		t.Errorf("X-Webhook-Signature = %q, want %q", got, want)
+	}
+
+	body, err := delivery.body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(request.body) != string(body) {
+		t.Errorf("body = %s, want %s", request.body, body)
+	}
+
+	if delivery.Status != WebhookDeliveryStatusSucceeded {
+		t.Errorf("status = %q, want %q", delivery.Status, WebhookDeliveryStatusSucceeded)
+	}
+	if delivery.Attempts != 1 {
+		t.Errorf("attempts = %d, want 1", delivery.Attempts)
+	}
+	if delivery.ResponseStatus == nil || *delivery.ResponseStatus != http.StatusNoContent {
+		t.Errorf("response status = %v, want %d", delivery.ResponseStatus, http.StatusNoContent)
+	}
+}
+
+func TestDeliverWebhookFailsAfterMaxAttempts(t *testing.T) {
+	server, requests := newWebhookTestServer(t, http.StatusInternalServerError, "internal response body")
+	service := newWebhookTestService(server.Client())
+
+	webhook := Webhook{ID: 1, URL: server.URL, Secret: "topsecret", IsActive: true}
+	delivery := newWebhookTestDelivery()
+
+	for attempt := int32(1); attempt <= webhookMaxAttempts; attempt++ {
+		before := time.Now()
+		service.deliverWebhook(context.Background(), webhook, &delivery)
+		<-requests
+
+		if delivery.Attempts != attempt {
+			t.Fatalf("attempts = %d, want %d", delivery.Attempts, attempt)
+		}
+		if delivery.ResponseStatus == nil || *delivery.ResponseStatus != http.StatusInternalServerError {
+			t.Fatalf("response status = %v, want %d", delivery.ResponseStatus, http.StatusInternalServerError)
+		}
+		if delivery.Error == nil || strings.Contains(*delivery.Error, "internal response body") {
+			t.Fatalf("error = %v, want a message without the response body", delivery.Error)
+		}
+
+		if attempt == webhookMaxAttempts {
+			break
+		}
+
+		if delivery.Status != WebhookDeliveryStatusPending {
+			t.Fatalf("attempt %d: status = %q, want %q", attempt, delivery.Status, WebhookDeliveryStatusPending)
+		}
+		delay := delivery.NextAttemptAt.Sub(before)
+		if want := webhookRetryDelay(attempt); delay < want || delay > want+time.Second {
+			t.Fatalf("attempt %d: next attempt in %v, want %v", attempt, delay, want)
+		}
+	}
+
+	if delivery.Status != WebhookDeliveryStatusFailed {
+		t.Errorf("status = %q, want %q", delivery.Status, WebhookDeliveryStatusFailed)
+	}
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{attempts: 1, want: 30 * time.Second},
+		{attempts: 2, want: time.Minute},
+		{attempts: 3, want: 2 * time.Minute},
+		{attempts: 4, want: 4 * time.Minute},
+		{attempts: 7, want: 32 * time.Minute},
+		{attempts: 10, want: 256 * time.Minute},
+		{attempts: 11, want: webhookMaxRetryDelay},
+		{attempts: 50, want: webhookMaxRetryDelay},
+	}
+
+	for _, tt := range tests {
+		if got := webhookRetryDelay(tt.attempts); got != tt.want {
+			t.Errorf("webhookRetryDelay(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestWebhookClientRejectsInternalDestinations(t *testing.T) {
+	server, requests := newWebhookTestServer(t, http.StatusOK, "")
+	service := newWebhookTestService(NewWebhookClient())
+
+	webhook := Webhook{ID: 1, URL: server.URL, Secret: "topsecret", IsActive: true}
+	delivery := newWebhookTestDelivery()
+
+	service.deliverWebhook(context.Background(), webhook, &delivery)
+
+	select {
+	case <-requests:
+		t.Fatal("request reached a loopback server")
+	default:
+	}
+
+	if delivery.Error == nil || *delivery.Error != errWebhookDestinationNotAllowed.Error() {
+		t.Errorf("error = %v, want %q", delivery.Error, errWebhookDestinationNotAllowed)
+	}
+	if delivery.Status != WebhookDeliveryStatusPending || delivery.Attempts != 1 {
+		t.Errorf("status = %q after %d attempts, want a pending retry", delivery.Status, delivery.Attempts)
+	}
+}
+
+func TestWebhookClientDoesNotFollowRedirects(t *testing.T) {
+	client := NewWebhookClient()
+
+	if err := client.CheckRedirect(nil, nil); !errors.Is(err, http.ErrUseLastResponse) {
+		t.Errorf("CheckRedirect = %v, want %v", err, http.ErrUseLastResponse)
+	}
+}
+
+func TestWebhookAddressAllowed(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "93.184.216.34", want: true},
+		{addr: "2606:4700:4700::1111", want: true},
+		{addr: "127.0.0.1", want: false},
+		{addr: "::1", want: false},
+		{addr: "10.96.0.1", want: false},
+		{addr: "172.16.5.4", want: false},
+		{addr: "192.168.1.1", want: false},
+		{addr: "169.254.169.254", want: false},
+		{addr: "fe80::1", want: false},
+		{addr: "fd00::1", want: false},
+		{addr: "100.64.0.1", want: false},
+		{addr: "0.0.0.0", want: false},
+		{addr: "::ffff:127.0.0.1", want: false},
+		{addr: "224.0.0.1", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := webhookAddressAllowed(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("webhookAddressAllowed(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		allowed bool
+	}{
+		{url: "https://example.com/hooks", allowed: true},
+		{url: "http://93.184.216.34:8080/hooks", allowed: true},
+		{url: "http://localhost:8080/hooks", allowed: false},
+		{url: "http://app.localhost/hooks", allowed: false},
+		{url: "http://169.254.169.254/latest/meta-data", allowed: false},
+		{url: "http://[::1]/hooks", allowed: false},
+		{url: "http://mission-service/hooks", allowed: false},
+		{url: "http://mission-service.app.svc/hooks", allowed: false},
+		{url: "http://mission-service.app.svc.cluster.local/hooks", allowed: false},
+		{url: "http://metadata.google.internal/hooks", allowed: false},
+		{url: "HTTPS://example.com/hooks", allowed: true},
+		{url: "ftp://example.com/hooks", allowed: false},
+		{url: "file:///etc/passwd", allowed: false},
+		{url: "gopher://example.com/hooks", allowed: false},
+		{url: "ws://example.com/hooks", allowed: false},
+		{url: "example.com/hooks", allowed: false},
+		{url: "//example.com/hooks", allowed: false},
+		{url: "javascript:alert(1)", allowed: false},
+	}
+
+	for _, tt := range tests {
+		err := validateWebhookURL(tt.url)
+		if allowed := err == nil; allowed != tt.allowed {
+			t.Errorf("validateWebhookURL(%q) = %v, want allowed %v", tt.url, err, tt.allowed)
+		}
+	}
+}
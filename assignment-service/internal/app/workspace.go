@@ -0,0 +1,75 @@
+package app
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WorkspaceRole string
+
+const (
+	WorkspaceRoleMember WorkspaceRole = "member"
+	WorkspaceRoleOwner  WorkspaceRole = "owner"
+)
+
+type Workspace struct {
+	ID        int32             `json:"id" sql:"primary_key"`
+	Name      string            `json:"name"`
+	OwnerID   uuid.UUID         `json:"owner_id"`
+	CreatedAt *time.Time        `json:"created_at"`
+	UpdatedAt *time.Time        `json:"updated_at"`
+	Members   []WorkspaceMember `json:"members,omitempty"`
+}
+
+func (w Workspace) member(userID uuid.UUID) (WorkspaceMember, bool) {
+	for _, member := range w.Members {
+		if member.UserID == userID {
+			return member, true
+		}
+	}
+
+	return WorkspaceMember{}, false
+}
+
+type WorkspaceMember struct {
+	ID          int32         `json:"id" sql:"primary_key"`
+	WorkspaceID int32         `json:"workspace_id"`
+	UserID      uuid.UUID     `json:"user_id"`
+	Role        WorkspaceRole `json:"role"`
+	CreatedAt   *time.Time    `json:"created_at"`
+}
+
+type WorkspaceAssignment struct {
+	ID          int32      `json:"id" sql:"primary_key"`
+	WorkspaceID int32      `json:"workspace_id"`
+	PublishedBy uuid.UUID  `json:"published_by"`
+	Title       string     `json:"title"`
+	Note        *string    `json:"note"`
+	DueDate     *time.Time `json:"due_date"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+type WorkspaceMemberProgress struct {
+	UserID    uuid.UUID     `json:"user_id"`
+	Role      WorkspaceRole `json:"role"`
+	Assigned  int64         `json:"assigned"`
+	Completed int64         `json:"completed"`
+	Overdue   int64         `json:"overdue"`
+}
+
+type WorkspaceProgress struct {
+	WorkspaceID int32                     `json:"workspace_id"`
+	Published   int                       `json:"published"`
+	Members     []WorkspaceMemberProgress `json:"members"`
+}
+
+type CreateWorkspaceRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+type AddWorkspaceMemberRequest struct {
+	Email  string `json:"email" validate:"required_without=UserID,omitempty,email"`
+	UserID string `json:"user_id" validate:"required_without=Email,omitempty,uuid"`
+}
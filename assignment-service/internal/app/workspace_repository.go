@@ -0,0 +1,212 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/.gen/database/public/table"
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	//lint:ignore ST1001 "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var (
+	WorkspaceTable           = table.Workspaces.AS("workspace")
+	WorkspaceMemberTable     = table.WorkspaceMembers.AS("workspace_member")
+	WorkspaceAssignmentTable = table.WorkspaceAssignments.AS("workspace_assignment")
+)
+
+type WorkspaceRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewWorkspaceRepository(db *database.Service, tracer trace.Tracer) *WorkspaceRepository {
+	return &WorkspaceRepository{db, tracer}
+}
+
+func (r *WorkspaceRepository) InsertWorkspaceWithTransaction(ctx context.Context, tx *sql.Tx, workspace Workspace) (Workspace, error) {
+	ctx, span := r.tracer.Start(ctx, "WorkspaceRepository.InsertWorkspaceWithTransaction")
+	defer span.End()
+
+	query := WorkspaceTable.INSERT(WorkspaceTable.Name, WorkspaceTable.OwnerID).
+		VALUES(workspace.Name, workspace.OwnerID).
+		RETURNING(WorkspaceTable.AllColumns)
+
+	var w Workspace
+	err := query.QueryContext(ctx, tx, &w)
+
+	return w, err
+}
+
+func (r *WorkspaceRepository) InsertWorkspaceMemberWithTransaction(ctx context.Context, tx *sql.Tx, member WorkspaceMember) (WorkspaceMember, error) {
+	ctx, span := r.tracer.Start(ctx, "WorkspaceRepository.InsertWorkspaceMemberWithTransaction")
+	defer span.End()
+
+	query := WorkspaceMemberTable.INSERT(WorkspaceMemberTable.WorkspaceID, WorkspaceMemberTable.UserID, WorkspaceMemberTable.Role).
+		VALUES(member.WorkspaceID, member.UserID, member.Role).
+		RETURNING(WorkspaceMemberTable.AllColumns)
+
+	var m WorkspaceMember
+	err := query.QueryContext(ctx, tx, &m)
+
+	return m, err
+}
+
+func (r *WorkspaceRepository) InsertWorkspaceMember(ctx context.Context, member WorkspaceMember) (WorkspaceMember, error) {
+	ctx, span := r.tracer.Start(ctx, "WorkspaceRepository.InsertWorkspaceMember")
+	defer span.End()
+
+	query := WorkspaceMemberTable.INSERT(WorkspaceMemberTable.WorkspaceID, WorkspaceMemberTable.UserID, WorkspaceMemberTable.Role).
+		VALUES(member.WorkspaceID, member.UserID, member.Role).
+		RETURNING(WorkspaceMemberTable.AllColumns)
+
+	var m WorkspaceMember
+	err := query.QueryContext(ctx, r.db.Pool, &m)
+
+	return m, err
+}
+
+func (r *WorkspaceRepository) FindWorkspacesByUserID(ctx context.Context, userID uuid.UUID) ([]Workspace, error) {
+	ctx, span := r.tracer.Start(ctx, "WorkspaceRepository.FindWorkspacesByUserID")
+	defer span.End()
+
+	memberWorkspaceIDs := WorkspaceMemberTable.SELECT(WorkspaceMemberTable.WorkspaceID).
+		FROM(WorkspaceMemberTable).
+		WHERE(WorkspaceMemberTable.UserID.EQ(UUID(userID)))
+
+	query := WorkspaceTable.SELECT(WorkspaceTable.AllColumns).
+		FROM(WorkspaceTable).
+		WHERE(WorkspaceTable.ID.IN(memberWorkspaceIDs)).
+		ORDER_BY(WorkspaceTable.CreatedAt.ASC())
+
+	workspaces := []Workspace{}
+	err := query.QueryContext(ctx, r.db.Pool, &workspaces)
+
+	return workspaces, err
+}
+
+func (r *WorkspaceRepository) FindWorkspaceByIDJoinMembers(ctx context.Context, workspaceID int32) (Workspace, error) {
+	ctx, span := r.tracer.Start(ctx, "WorkspaceRepository.FindWorkspaceByIDJoinMembers")
+	defer span.End()
+
+	query := WorkspaceTable.SELECT(WorkspaceTable.AllColumns, WorkspaceMemberTable.AllColumns).
+		FROM(WorkspaceTable.INNER_JOIN(WorkspaceMemberTable, WorkspaceTable.ID.EQ(WorkspaceMemberTable.WorkspaceID))).
+		WHERE(WorkspaceTable.ID.EQ(Int32(workspaceID))).
+		ORDER_BY(WorkspaceMemberTable.CreatedAt.ASC())
+
+	var w Workspace
+	err := query.QueryContext(ctx, r.db.Pool, &w)
+
+	return w, err
+}
+
+func (r *WorkspaceRepository) FindWorkspaceMemberForShareWithTransaction(ctx context.Context, tx *sql.Tx, workspaceID int32, userID uuid.UUID) (WorkspaceMember, error) {
+	ctx, span := r.tracer.Start(ctx, "WorkspaceRepository.FindWorkspaceMemberForShareWithTransaction")
+	defer span.End()
+
+	query := WorkspaceMemberTable.SELECT(WorkspaceMemberTable.AllColumns).
+		FROM(WorkspaceMemberTable).
+		WHERE(WorkspaceMemberTable.WorkspaceID.EQ(Int32(workspaceID)).AND(WorkspaceMemberTable.UserID.EQ(UUID(userID)))).
+		FOR(SHARE())
+
+	var m WorkspaceMember
+	err := query.QueryContext(ctx, tx, &m)
+
+	return m, err
+}
+
+func (r *WorkspaceRepository) DeleteWorkspaceByID(ctx context.Context, workspaceID int32) error {
+	ctx, span := r.tracer.Start(ctx, "WorkspaceRepository.DeleteWorkspaceByID")
+	defer span.End()
+
+	query := WorkspaceTable.DELETE().WHERE(WorkspaceTable.ID.EQ(Int32(workspaceID)))
+
+	_, err := query.ExecContext(ctx, r.db.Pool)
+
+	return err
+}
+
+func (r *WorkspaceRepository) DeleteWorkspaceMember(ctx context.Context, workspaceID int32, userID uuid.UUID) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "WorkspaceRepository.DeleteWorkspaceMember")
+	defer span.End()
+
+	query := WorkspaceMemberTable.DELETE().
+		WHERE(WorkspaceMemberTable.WorkspaceID.EQ(Int32(workspaceID)).AND(WorkspaceMemberTable.UserID.EQ(UUID(userID))))
+
+	result, err := query.ExecContext(ctx, r.db.Pool)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (r *WorkspaceRepository) InsertWorkspaceAssignmentWithTransaction(ctx context.Context, tx *sql.Tx, workspaceAssignment WorkspaceAssignment) (WorkspaceAssignment, error) {
+	ctx, span := r.tracer.Start(ctx, "WorkspaceRepository.InsertWorkspaceAssignmentWithTransaction")
+	defer span.End()
+
+	query := WorkspaceAssignmentTable.INSERT(
+		WorkspaceAssignmentTable.WorkspaceID,
+		WorkspaceAssignmentTable.PublishedBy,
+		WorkspaceAssignmentTable.Title,
+		WorkspaceAssignmentTable.Note,
+		WorkspaceAssignmentTable.DueDate,
+	).
+		VALUES(workspaceAssignment.WorkspaceID, workspaceAssignment.PublishedBy, workspaceAssignment.Title, workspaceAssignment.Note, workspaceAssignment.DueDate).
+		RETURNING(WorkspaceAssignmentTable.AllColumns)
+
+	var wa WorkspaceAssignment
+	err := query.QueryContext(ctx, tx, &wa)
+
+	return wa, err
+}
+
+func (r *WorkspaceRepository) FindWorkspaceAssignmentsByWorkspaceID(ctx context.Context, workspaceID int32) ([]WorkspaceAssignment, error) {
+	ctx, span := r.tracer.Start(ctx, "WorkspaceRepository.FindWorkspaceAssignmentsByWorkspaceID")
+	defer span.End()
+
+	query := WorkspaceAssignmentTable.SELECT(WorkspaceAssignmentTable.AllColumns).
+		FROM(WorkspaceAssignmentTable).
+		WHERE(WorkspaceAssignmentTable.WorkspaceID.EQ(Int32(workspaceID))).
+		ORDER_BY(WorkspaceAssignmentTable.CreatedAt.DESC())
+
+	workspaceAssignments := []WorkspaceAssignment{}
+	err := query.QueryContext(ctx, r.db.Pool, &workspaceAssignments)
+
+	return workspaceAssignments, err
+}
+
+func (r *WorkspaceRepository) FindWorkspaceMemberProgress(ctx context.Context, workspaceID int32) ([]WorkspaceMemberProgress, error) {
+	ctx, span := r.tracer.Start(ctx, "WorkspaceRepository.FindWorkspaceMemberProgress")
+	defer span.End()
+
+	isCompleted := AssignmentTable.IsCompleted.IS_TRUE()
+	isOverdue := AssignmentTable.IsCompleted.IS_NOT_TRUE().AND(AssignmentTable.DueDate.LT(NOW()))
+
+	query := SELECT(
+		WorkspaceMemberTable.UserID.AS("workspace_member_progress.user_id"),
+		WorkspaceMemberTable.Role.AS("workspace_member_progress.role"),
+		COUNT(AssignmentTable.ID).AS("workspace_member_progress.assigned"),
+		COUNT(CASE().WHEN(isCompleted).THEN(AssignmentTable.ID)).AS("workspace_member_progress.completed"),
+		COUNT(CASE().WHEN(isOverdue).THEN(AssignmentTable.ID)).AS("workspace_member_progress.overdue"),
+	).
+		FROM(
+			WorkspaceMemberTable.
+				LEFT_JOIN(WorkspaceAssignmentTable, WorkspaceAssignmentTable.WorkspaceID.EQ(WorkspaceMemberTable.WorkspaceID)).
+				LEFT_JOIN(AssignmentTable, AssignmentTable.WorkspaceAssignmentID.EQ(WorkspaceAssignmentTable.ID).
+					AND(AssignmentTable.UserID.EQ(WorkspaceMemberTable.UserID)).
+					AND(AssignmentTable.DeletedAt.IS_NULL())),
+		).
+		WHERE(WorkspaceMemberTable.WorkspaceID.EQ(Int32(workspaceID))).
+		GROUP_BY(WorkspaceMemberTable.UserID, WorkspaceMemberTable.Role, WorkspaceMemberTable.CreatedAt).
+		ORDER_BY(WorkspaceMemberTable.CreatedAt.ASC())
+
+	progress := []WorkspaceMemberProgress{}
+	err := query.QueryContext(ctx, r.db.Pool, &progress)
+
+	return progress, err
+}
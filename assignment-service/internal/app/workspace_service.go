@@ -0,0 +1,295 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) getAuthorizedWorkspaceByID(ctx context.Context, userID uuid.UUID, workspaceID int32, required WorkspaceRole) (Workspace, ServiceError) {
+	workspace, err := s.workspaceRepository.FindWorkspaceByIDJoinMembers(ctx, workspaceID)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return Workspace{}, NewClientError(http.StatusNotFound, errors.New("workspace not found"))
+	}
+	if err != nil {
+		return Workspace{}, NewInternalServiceError(err)
+	}
+
+	if _, ok := workspace.member(userID); !ok {
+		return Workspace{}, NewClientError(http.StatusForbidden, errors.New("user is not a member of workspace"))
+	}
+	if required == WorkspaceRoleOwner && workspace.OwnerID != userID {
+		return Workspace{}, NewClientError(http.StatusForbidden, errors.New("workspace requires owner role"))
+	}
+
+	return workspace, nil
+}
+
+func (s *Service) CreateWorkspace(ctx context.Context, userID uuid.UUID, request CreateWorkspaceRequest) (Workspace, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.CreateWorkspace")
+	defer span.End()
+
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return Workspace{}, NewInternalServiceError(err)
+	}
+
+	workspace, err := s.workspaceRepository.InsertWorkspaceWithTransaction(ctx, tx, Workspace{
+		Name:    request.Name,
+		OwnerID: userID,
+	})
+	if err != nil {
+		tx.Rollback()
+		return Workspace{}, NewInternalServiceError(err)
+	}
+
+	owner, err := s.workspaceRepository.InsertWorkspaceMemberWithTransaction(ctx, tx, WorkspaceMember{
+		WorkspaceID: workspace.ID,
+		UserID:      userID,
+		Role:        WorkspaceRoleOwner,
+	})
+	if err != nil {
+		tx.Rollback()
+		return Workspace{}, NewInternalServiceError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Workspace{}, NewInternalServiceError(err)
+	}
+
+	workspace.Members = []WorkspaceMember{owner}
+
+	return workspace, nil
+}
+
+func (s *Service) GetWorkspaces(ctx context.Context, userID uuid.UUID) ([]Workspace, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetWorkspaces")
+	defer span.End()
+
+	workspaces, err := s.workspaceRepository.FindWorkspacesByUserID(ctx, userID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return workspaces, nil
+}
+
+func (s *Service) GetWorkspaceByID(ctx context.Context, userID uuid.UUID, workspaceID int32) (Workspace, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetWorkspaceByID")
+	defer span.End()
+
+	return s.getAuthorizedWorkspaceByID(ctx, userID, workspaceID, WorkspaceRoleMember)
+}
+
+func (s *Service) DeleteWorkspaceByID(ctx context.Context, userID uuid.UUID, workspaceID int32) ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.DeleteWorkspaceByID")
+	defer span.End()
+
+	workspace, serviceErr := s.getAuthorizedWorkspaceByID(ctx, userID, workspaceID, WorkspaceRoleOwner)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	if err := s.workspaceRepository.DeleteWorkspaceByID(ctx, workspace.ID); err != nil {
+		return NewInternalServiceError(err)
+	}
+
+	return nil
+}
+
+func (s *Service) AddWorkspaceMember(ctx context.Context, userID uuid.UUID, workspaceID int32, request AddWorkspaceMemberRequest) (WorkspaceMember, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.AddWorkspaceMember")
+	defer span.End()
+
+	workspace, serviceErr := s.getAuthorizedWorkspaceByID(ctx, userID, workspaceID, WorkspaceRoleOwner)
+	if serviceErr != nil {
+		return WorkspaceMember{}, serviceErr
+	}
+
+	memberID, serviceErr := s.resolveUser(ctx, request.Email, request.UserID)
+	if serviceErr != nil {
+		return WorkspaceMember{}, serviceErr
+	}
+	if _, ok := workspace.member(memberID); ok {
+		return WorkspaceMember{}, NewClientError(http.StatusConflict, errors.New("user is already a member of workspace"))
+	}
+
+	member, err := s.workspaceRepository.InsertWorkspaceMember(ctx, WorkspaceMember{
+		WorkspaceID: workspace.ID,
+		UserID:      memberID,
+		Role:        WorkspaceRoleMember,
+	})
+	if err != nil {
+		return WorkspaceMember{}, NewInternalServiceError(err)
+	}
+
+	return member, nil
+}
+
+// checkWorkspaceMemberWithTransaction holds a share lock on the user's
+// membership so they cannot leave the workspace before tx commits.
+func (s *Service) checkWorkspaceMemberWithTransaction(ctx context.Context, tx *sql.Tx, userID uuid.UUID, workspaceID int32) ServiceError {
+	_, err := s.workspaceRepository.FindWorkspaceMemberForShareWithTransaction(ctx, tx, workspaceID, userID)
+	if errors.Is(err, qrm.ErrNoRows) {
+		return NewClientError(http.StatusNotFound, errors.New("workspace not found"))
+	}
+	if err != nil {
+		return NewInternalServiceError(err)
+	}
+
+	return nil
+}
+
+func (s *Service) RemoveWorkspaceMember(ctx context.Context, userID uuid.UUID, workspaceID int32, memberID uuid.UUID) ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.RemoveWorkspaceMember")
+	defer span.End()
+
+	required := WorkspaceRoleOwner
+	if memberID == userID {
+		required = WorkspaceRoleMember
+	}
+
+	workspace, serviceErr := s.getAuthorizedWorkspaceByID(ctx, userID, workspaceID, required)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if memberID == workspace.OwnerID {
+		return NewClientError(http.StatusBadRequest, errors.New("workspace owner cannot leave workspace"))
+	}
+
+	deleted, err := s.workspaceRepository.DeleteWorkspaceMember(ctx, workspace.ID, memberID)
+	if err != nil {
+		return NewInternalServiceError(err)
+	}
+	if deleted == 0 {
+		return NewClientError(http.StatusNotFound, errors.New("workspace member not found"))
+	}
+
+	return nil
+}
+
+func (s *Service) PublishWorkspaceAssignment(ctx context.Context, userID uuid.UUID, workspaceID int32, request CreateAssignmentRequest) (WorkspaceAssignment, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.PublishWorkspaceAssignment")
+	defer span.End()
+
+	workspace, serviceErr := s.getAuthorizedWorkspaceByID(ctx, userID, workspaceID, WorkspaceRoleOwner)
+	if serviceErr != nil {
+		return WorkspaceAssignment{}, serviceErr
+	}
+
+	tx, err := s.repository.BeginTransaction(ctx)
+	if err != nil {
+		return WorkspaceAssignment{}, NewInternalServiceError(err)
+	}
+
+	workspaceAssignment, err := s.workspaceRepository.InsertWorkspaceAssignmentWithTransaction(ctx, tx, WorkspaceAssignment{
+		WorkspaceID: workspace.ID,
+		PublishedBy: userID,
+		Title:       request.Title,
+		Note:        request.Note,
+		DueDate:     request.DueDate,
+	})
+	if err != nil {
+		tx.Rollback()
+		return WorkspaceAssignment{}, NewInternalServiceError(err)
+	}
+
+	copies := make([]Assignment, len(workspace.Members))
+	for i, member := range workspace.Members {
+		copies[i], _ = request.toAssignmentAndReminders(member.UserID)
+		copies[i].WorkspaceAssignmentID = &workspaceAssignment.ID
+		copies[i].SearchConfig = s.userSearchConfig(ctx, member.UserID)
+	}
+
+	copies, err = s.assignmentRepository.InsertAssignmentsWithTransaction(ctx, tx, copies)
+	if err != nil {
+		tx.Rollback()
+		return WorkspaceAssignment{}, NewInternalServiceError(err)
+	}
+
+	_, reminders := request.toAssignmentAndReminders(userID)
+	if len(reminders) > 0 {
+		copyReminders := make([]Reminder, 0, len(copies)*len(reminders))
+		for i := range copies {
+			memberReminders, serviceErr := s.scheduleReminders(ctx, copies[i].UserID, copies[i].DueDate, reminders)
+			if serviceErr != nil {
+				tx.Rollback()
+				return WorkspaceAssignment{}, serviceErr
+			}
+
+			for _, reminder := range memberReminders {
+				reminder.AssignmentID = copies[i].ID
+				copyReminders = append(copyReminders, reminder)
+				copies[i].Reminders = append(copies[i].Reminders, reminder)
+			}
+		}
+
+		if _, err := s.reminderRepository.InsertRemindersWithTransaction(ctx, tx, copyReminders); err != nil {
+			tx.Rollback()
+			return WorkspaceAssignment{}, NewInternalServiceError(err)
+		}
+	}
+
+	changes := make([]AssignmentChange, len(copies))
+	for i, assignment := range copies {
+		changes[i] = newAssignmentChange(userID, AssignmentChangeActionCreate, nil, assignment)
+	}
+
+	if err := s.recordAssignmentChangesWithTransaction(ctx, tx, changes); err != nil {
+		tx.Rollback()
+		return WorkspaceAssignment{}, NewInternalServiceError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return WorkspaceAssignment{}, NewInternalServiceError(err)
+	}
+
+	return workspaceAssignment, nil
+}
+
+func (s *Service) GetWorkspaceAssignments(ctx context.Context, userID uuid.UUID, workspaceID int32) ([]WorkspaceAssignment, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetWorkspaceAssignments")
+	defer span.End()
+
+	workspace, serviceErr := s.getAuthorizedWorkspaceByID(ctx, userID, workspaceID, WorkspaceRoleMember)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	workspaceAssignments, err := s.workspaceRepository.FindWorkspaceAssignmentsByWorkspaceID(ctx, workspace.ID)
+	if err != nil {
+		return nil, NewInternalServiceError(err)
+	}
+
+	return workspaceAssignments, nil
+}
+
+func (s *Service) GetWorkspaceProgress(ctx context.Context, userID uuid.UUID, workspaceID int32) (WorkspaceProgress, ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetWorkspaceProgress")
+	defer span.End()
+
+	workspace, serviceErr := s.getAuthorizedWorkspaceByID(ctx, userID, workspaceID, WorkspaceRoleOwner)
+	if serviceErr != nil {
+		return WorkspaceProgress{}, serviceErr
+	}
+
+	workspaceAssignments, err := s.workspaceRepository.FindWorkspaceAssignmentsByWorkspaceID(ctx, workspace.ID)
+	if err != nil {
+		return WorkspaceProgress{}, NewInternalServiceError(err)
+	}
+
+	members, err := s.workspaceRepository.FindWorkspaceMemberProgress(ctx, workspace.ID)
+	if err != nil {
+		return WorkspaceProgress{}, NewInternalServiceError(err)
+	}
+
+	return WorkspaceProgress{
+		WorkspaceID: workspace.ID,
+		Published:   len(workspaceAssignments),
+		Members:     members,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+func (h *HttpHandler) GetAssignmentHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.GetAssignmentHistory")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	assignmentID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	changes, serviceErr := h.service.GetAssignmentHistory(ctx, uuid.MustParse(userID), int32(assignmentID))
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, changes)
+}
+
+func (h *HttpHandler) UndoAssignmentChange(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.UndoAssignmentChange")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	assignmentID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	assignment, serviceErr := h.service.UndoAssignmentChange(ctx, uuid.MustParse(userID), int32(assignmentID))
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	w.Header().Set("ETag", assignment.ETag())
+	h.responseWriter.WriteSuccessResponse(ctx, w, assignment)
+}
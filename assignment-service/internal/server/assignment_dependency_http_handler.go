@@ -0,0 +1,125 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+func (h *HttpHandler) GetAssignmentDependencies(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.GetAssignmentDependencies")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	assignmentID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	prerequisites, serviceErr := h.service.GetAssignmentDependencies(ctx, uuid.MustParse(userID), int32(assignmentID))
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, prerequisites)
+}
+
+func (h *HttpHandler) AddAssignmentDependency(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.AddAssignmentDependency")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	assignmentID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	var request app.CreateAssignmentDependencyRequest
+	if err := h.requestDecoder.Decode(ctx, r, &request); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if err := h.validator.Validate(ctx, request); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	dependency, serviceErr := h.service.AddAssignmentDependency(ctx, uuid.MustParse(userID), int32(assignmentID), request)
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteJSONResponse(ctx, w, http.StatusCreated, http.StatusText(http.StatusCreated), dependency)
+}
+
+func (h *HttpHandler) RemoveAssignmentDependency(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.RemoveAssignmentDependency")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	assignmentID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	blockedByID, err := strconv.Atoi(chi.URLParam(r, "blockedByID"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if serviceErr := h.service.RemoveAssignmentDependency(ctx, uuid.MustParse(userID), int32(assignmentID), int32(blockedByID)); serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, nil)
+}
+
+func (h *HttpHandler) GetNextAssignments(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.GetNextAssignments")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	assignments, serviceErr := h.service.GetNextAssignments(ctx, uuid.MustParse(userID))
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, assignments)
+}
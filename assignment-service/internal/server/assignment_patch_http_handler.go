@@ -0,0 +1,57 @@
+package server
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+func (h *HttpHandler) PatchAssignmentByID(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.PatchAssignmentByID")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	assignmentID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || (mediaType != "application/merge-patch+json" && mediaType != "application/json") {
+		code := http.StatusUnsupportedMediaType
+		h.responseWriter.WriteErrorResponse(ctx, w, code, http.StatusText(code))
+		return
+	}
+
+	var patch app.AssignmentMergePatch
+	if err := h.requestDecoder.Decode(ctx, r, &patch); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if err := h.validator.Validate(ctx, patch); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	assignment, serviceErr := h.service.PatchAssignmentByID(ctx, uuid.MustParse(userID), int32(assignmentID), r.Header.Get("If-Match"), patch)
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	w.Header().Set("ETag", assignment.ETag())
+	h.responseWriter.WriteSuccessResponse(ctx, w, assignment)
+}
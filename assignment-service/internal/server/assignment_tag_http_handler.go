@@ -0,0 +1,74 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+func (h *HttpHandler) GetAssignmentTags(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.GetAssignmentTags")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	assignmentID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	tags, serviceErr := h.service.GetAssignmentTags(ctx, uuid.MustParse(userID), int32(assignmentID))
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, tags)
+}
+
+func (h *HttpHandler) UpdateAssignmentTags(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.UpdateAssignmentTags")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	assignmentID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	var request app.UpdateAssignmentTagsRequest
+	if err := h.requestDecoder.Decode(ctx, r, &request); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if err := h.validator.Validate(ctx, request); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	tags, serviceErr := h.service.UpdateAssignmentTags(ctx, uuid.MustParse(userID), int32(assignmentID), request)
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, tags)
+}
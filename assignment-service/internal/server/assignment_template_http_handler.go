@@ -0,0 +1,187 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+func (h *HttpHandler) CreateAssignmentTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.CreateAssignmentTemplate")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	var request app.AssignmentTemplateRequest
+	if err := h.requestDecoder.Decode(ctx, r, &request); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if err := h.validator.Validate(ctx, request); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	template, serviceErr := h.service.CreateAssignmentTemplate(ctx, uuid.MustParse(userID), request)
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteJSONResponse(ctx, w, http.StatusCreated, http.StatusText(http.StatusCreated), template)
+}
+
+func (h *HttpHandler) GetAssignmentTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.GetAssignmentTemplates")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	templates, serviceErr := h.service.GetAssignmentTemplates(ctx, uuid.MustParse(userID))
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, templates)
+}
+
+func (h *HttpHandler) GetAssignmentTemplateByID(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.GetAssignmentTemplateByID")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	templateID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	template, serviceErr := h.service.GetAssignmentTemplateByID(ctx, uuid.MustParse(userID), int32(templateID))
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, template)
+}
+
+func (h *HttpHandler) UpdateAssignmentTemplateByID(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.UpdateAssignmentTemplateByID")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	templateID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	var request app.AssignmentTemplateRequest
+	if err := h.requestDecoder.Decode(ctx, r, &request); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if err := h.validator.Validate(ctx, request); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	template, serviceErr := h.service.UpdateAssignmentTemplateByID(ctx, uuid.MustParse(userID), int32(templateID), request)
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, template)
+}
+
+func (h *HttpHandler) DeleteAssignmentTemplateByID(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.DeleteAssignmentTemplateByID")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	templateID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if serviceErr := h.service.DeleteAssignmentTemplateByID(ctx, uuid.MustParse(userID), int32(templateID)); serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, nil)
+}
+
+func (h *HttpHandler) CreateAssignmentFromTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.CreateAssignmentFromTemplate")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	templateID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	var request app.CreateAssignmentFromTemplateRequest
+	if err := h.requestDecoder.Decode(ctx, r, &request); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if err := h.validator.Validate(ctx, request); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	assignment, serviceErr := h.service.CreateAssignmentFromTemplate(ctx, uuid.MustParse(userID), int32(templateID), request)
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteJSONResponse(ctx, w, http.StatusCreated, http.StatusText(http.StatusCreated), assignment)
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/google/uuid"
+)
+
+func (h *HttpHandler) BatchAssignments(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.BatchAssignments")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	var request app.BatchAssignmentRequest
+	if err := h.requestDecoder.Decode(ctx, r, &request); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if err := h.validator.Validate(ctx, request); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	result, serviceErr := h.service.BatchAssignments(ctx, uuid.MustParse(userID), request)
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, result)
+}
@@ -1,31 +1,84 @@
 package server
 
 import (
+	"time"
+
 	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
 	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/assignmentservice"
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/authservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/middleware"
 	"github.com/MasLazu/dev-ops-porto/pkg/monitoring"
 	"github.com/MasLazu/dev-ops-porto/pkg/server"
 	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"go.opentelemetry.io/otel"
 )
 
-func bootstrap(config config, db *database.Service, logger *monitoring.Logger, missionServiceClient missionservice.MissionServiceClient) *server.HttpServer {
+func bootstrap(config config, db *database.Service, logger *monitoring.Logger, missionServiceClient missionservice.MissionServiceClient, authServiceClient authservice.AuthServiceClient) (*server.HttpServer, *server.GrpcServer, *TrashPurger, *WebhookDispatcher, *UserEventListener) {
+	s3Client := s3.NewFromConfig(config.aws.awsConfig, func(o *s3.Options) {
+		o.UsePathStyle = true
+		o.BaseEndpoint = aws.String(config.aws.s3.enpoint)
+	})
+
 	tracer := otel.Tracer(config.serviceName)
 	responseWriter := util.NewResponseWriter(tracer)
 	requestDecoder := util.NewRequestBodyDecoder(tracer)
 	validator := util.NewValidator(tracer)
 	handlerTracer := util.NewHandlerTracer(tracer)
-	repository := app.NewRepository(db)
-	assignmentRepository := app.NewAssignmentRepository(db, tracer)
-	reminderRepository := app.NewReminderRepository(db, tracer)
-	service := app.NewService(tracer, repository, assignmentRepository, reminderRepository, missionServiceClient)
+	service := app.NewService(app.ServiceDependencies{
+		Tracer:                         tracer,
+		Repository:                     app.NewRepository(db),
+		AssignmentRepository:           app.NewAssignmentRepository(db, tracer),
+		ReminderRepository:             app.NewReminderRepository(db, tracer),
+		AttachmentRepository:           app.NewAttachmentRepository(db, tracer),
+		CalendarFeedRepository:         app.NewCalendarFeedRepository(db, tracer),
+		CollaboratorRepository:         app.NewCollaboratorRepository(db, tracer),
+		WorkspaceRepository:            app.NewWorkspaceRepository(db, tracer),
+		AssignmentChangeRepository:     app.NewAssignmentChangeRepository(db, tracer),
+		StatsRepository:                app.NewStatsRepository(db, tracer),
+		FocusSessionRepository:         app.NewFocusSessionRepository(db, tracer),
+		AssignmentTagRepository:        app.NewAssignmentTagRepository(db, tracer),
+		QuietHoursRepository:           app.NewQuietHoursRepository(db, tracer),
+		AssignmentTemplateRepository:   app.NewAssignmentTemplateRepository(db, tracer),
+		AssignmentDependencyRepository: app.NewAssignmentDependencyRepository(db, tracer),
+		WebhookRepository:              app.NewWebhookRepository(db, tracer),
+		UserEventRepository:            app.NewUserEventRepository(db, tracer),
+		SyncRepository:                 app.NewSyncRepository(db, tracer),
+		MissionServiceClient:           missionServiceClient,
+		AuthServiceClient:              authServiceClient,
+		S3Client:                       s3Client,
+		WebhookClient:                  app.NewWebhookClient(),
+		UserEventHub:                   app.NewUserEventHub(),
+		AttachmentConfig: app.AttachmentConfig{
+			Bucket:              config.aws.s3.bucketNames.attachments,
+			UserQuota:           config.attachment.userQuota,
+			AllowedContentTypes: config.attachment.allowedContentTypes,
+			SigningKey:          config.attachment.signingKey,
+		},
+		StaticServiceEnpoint: config.staticServiceEnpoint,
+		CalendarFeedEndpoint: config.calendarFeedEndpoint,
+	})
 	authMiddleware := middleware.NewAuthMiddleware(config.jwtSecret, responseWriter, handlerTracer)
 	httpHandler := NewHttpHandler(service, authMiddleware, handlerTracer, responseWriter, requestDecoder, validator)
+	grpcHandler := NewGrpcHandler(tracer, service)
+
+	trashPurger := NewTrashPurger(service, time.Duration(config.trashRetentionDays)*24*time.Hour, logger)
+	webhookDispatcher := NewWebhookDispatcher(service, logger)
+	userEventListener := NewUserEventListener(service, logger)
 
-	return server.NewHttpServer(server.HttpServerConfig{
+	httpServer := server.NewHttpServer(server.HttpServerConfig{
 		Port:        config.port,
 		ServiceName: config.serviceName,
 	}, httpHandler.setupRoutes, handlerTracer, responseWriter, logger)
+
+	grpcServer := server.NewGrpcServer(server.GrpcServerConfig{
+		Port:        config.grpcPort,
+		ServiceName: config.serviceName,
+	}, logger)
+	assignmentservice.RegisterAssignmentServiceServer(grpcServer.Server, grpcHandler)
+
+	return httpServer, grpcServer, trashPurger, webhookDispatcher, userEventListener
 }
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+func (h *HttpHandler) GetCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.GetCalendarFeed")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	feed, serviceErr := h.service.GetCalendarFeed(ctx, uuid.MustParse(userID))
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, feed)
+}
+
+func (h *HttpHandler) RotateCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.RotateCalendarFeed")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	feed, serviceErr := h.service.RotateCalendarFeed(ctx, uuid.MustParse(userID))
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, feed)
+}
+
+func (h *HttpHandler) RevokeCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.RevokeCalendarFeed")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	if serviceErr := h.service.RevokeCalendarFeed(ctx, uuid.MustParse(userID)); serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, nil)
+}
+
+func (h *HttpHandler) ServeCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.ServeCalendarFeed")
+	defer span.End()
+
+	token := strings.TrimSuffix(chi.URLParam(r, "token"), ".ics")
+
+	component := app.CalendarComponentEvent
+	if strings.EqualFold(r.URL.Query().Get("component"), "todo") {
+		component = app.CalendarComponentTodo
+	}
+
+	feed, serviceErr := h.service.RenderCalendarFeed(ctx, token, component, r.Header.Get("If-None-Match"))
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	w.Header().Set("ETag", feed.ETag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+
+	if feed.NotModified {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="assignments.ics"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(feed.Body)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(feed.Body)
+}
@@ -1,40 +1,116 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 )
 
+type bucketNames struct {
+	attachments string
+}
+
+type s3Config struct {
+	enpoint     string
+	bucketNames bucketNames
+}
+
+type AwsConfig struct {
+	awsConfig aws.Config
+	s3        s3Config
+}
+
+type attachmentConfig struct {
+	userQuota           int64
+	allowedContentTypes []string
+	signingKey          []byte
+}
+
 type config struct {
 	port                     int
+	grpcPort                 int
 	otlpDomain               string
 	grpcMissionServiceDomain string
+	grpcAuthServiceDomain    string
 	database                 database.Config
 	serviceName              string
 	jwtSecret                []byte
+	aws                      AwsConfig
+	attachment               attachmentConfig
+	staticServiceEnpoint     string
+	calendarFeedEndpoint     string
+	trashRetentionDays       int
 }
 
-func getConfig() (config, error) {
+func getConfig(ctx context.Context) (config, error) {
 	port, err := getIntEnv("PORT")
 	if err != nil {
 		return config{}, err
 	}
 
+	grpcPort, err := getIntEnv("GRPC_PORT")
+	if err != nil {
+		return config{}, err
+	}
+
 	dbConfig, err := getDatabaseConfig()
 	if err != nil {
 		return config{}, fmt.Errorf("failed to get database config: %w", err)
 	}
 
+	awsConfig, err := getAwsConfig(ctx)
+	if err != nil {
+		return config{}, fmt.Errorf("failed to get S3 config: %w", err)
+	}
+
+	attachmentUserQuota, err := getIntEnv("ATTACHMENT_USER_QUOTA_BYTES")
+	if err != nil {
+		return config{}, err
+	}
+
+	attachmentSigningKey := os.Getenv("ATTACHMENT_SIGNING_KEY")
+	if attachmentSigningKey == "" {
+		return config{}, fmt.Errorf("ATTACHMENT_SIGNING_KEY is required")
+	}
+
+	trashRetentionDays, err := getIntEnv("TRASH_RETENTION_DAYS")
+	if err != nil {
+		return config{}, err
+	}
+
 	return config{
 		port:                     port,
+		grpcPort:                 grpcPort,
 		otlpDomain:               os.Getenv("OTLP_DOMAIN"),
 		jwtSecret:                []byte(os.Getenv("JWT_SECRET")),
 		database:                 dbConfig,
 		grpcMissionServiceDomain: os.Getenv("GRPC_MISSION_SERVICE_DOMAIN"),
+		grpcAuthServiceDomain:    os.Getenv("GRPC_AUTH_SERVICE_DOMAIN"),
 		serviceName:              "assignment-service",
+		staticServiceEnpoint:     os.Getenv("PUBLIC_STATIC_SERVICE_ENDPOINT"),
+		calendarFeedEndpoint:     os.Getenv("PUBLIC_CALENDAR_FEED_ENDPOINT"),
+		trashRetentionDays:       trashRetentionDays,
+		attachment: attachmentConfig{
+			userQuota:           int64(attachmentUserQuota),
+			allowedContentTypes: strings.Split(os.Getenv("ATTACHMENT_ALLOWED_CONTENT_TYPES"), ","),
+			signingKey:          []byte(attachmentSigningKey),
+		},
+		aws: AwsConfig{
+			awsConfig: awsConfig,
+			s3: s3Config{
+				enpoint: os.Getenv("S3_ENDPOINT"),
+				bucketNames: bucketNames{
+					attachments: os.Getenv("S3_BUCKET_ATTACHMENTS"),
+				},
+			},
+		},
 	}, nil
 }
 
@@ -49,6 +125,18 @@ func getIntEnv(key string) (int, error) {
 	return i, nil
 }
 
+func getAwsConfig(ctx context.Context) (aws.Config, error) {
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx,
+		awsconfig.WithRegion("us-west-2"),
+		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(os.Getenv("S3_ACCESS_KEY"), os.Getenv("S3_SECRET_KEY"), "")),
+	)
+	if err != nil {
+		return aws.Config{}, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	return awsCfg, nil
+}
+
 func getDatabaseConfig() (database.Config, error) {
 	port, err := strconv.Atoi(os.Getenv("DB_PORT"))
 	if err != nil {
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/assignmentservice"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GrpcHandler struct {
+	tracer  trace.Tracer
+	service *app.Service
+	assignmentservice.UnimplementedAssignmentServiceServer
+}
+
+func NewGrpcHandler(tracer trace.Tracer, service *app.Service) *GrpcHandler {
+	return &GrpcHandler{
+		tracer:  tracer,
+		service: service,
+	}
+}
+
+func (h *GrpcHandler) GetAssignment(ctx context.Context, req *assignmentservice.GetAssignmentRequest) (*assignmentservice.Assignment, error) {
+	ctx, span := h.tracer.Start(ctx, "GrpcHandler.GetAssignment")
+	defer span.End()
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	assignment, serviceErr := h.service.GetAssignmentByID(ctx, userID, req.Id)
+	if serviceErr != nil {
+		return nil, grpcStatusError(serviceErr)
+	}
+
+	return toProtoAssignment(assignment), nil
+}
+
+func (h *GrpcHandler) ListAssignments(ctx context.Context, req *assignmentservice.ListAssignmentsRequest) (*assignmentservice.ListAssignmentsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "GrpcHandler.ListAssignments")
+	defer span.End()
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	assignments, serviceErr := h.service.GetAssignments(ctx, userID)
+	if serviceErr != nil {
+		return nil, grpcStatusError(serviceErr)
+	}
+
+	res := &assignmentservice.ListAssignmentsResponse{
+		Assignments: make([]*assignmentservice.Assignment, len(assignments)),
+	}
+	for i, assignment := range assignments {
+		res.Assignments[i] = toProtoAssignment(assignment)
+	}
+
+	return res, nil
+}
+
+func (h *GrpcHandler) CountAssignments(ctx context.Context, req *assignmentservice.CountAssignmentsRequest) (*assignmentservice.CountAssignmentsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "GrpcHandler.CountAssignments")
+	defer span.End()
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	counts, serviceErr := h.service.CountAssignments(ctx, userID)
+	if serviceErr != nil {
+		return nil, grpcStatusError(serviceErr)
+	}
+
+	return &assignmentservice.CountAssignmentsResponse{
+		Total:     counts.Total,
+		Completed: counts.Completed,
+		Pending:   counts.Pending,
+		Overdue:   counts.Overdue,
+		Important: counts.Important,
+	}, nil
+}
+
+func (h *GrpcHandler) ExportAssignments(req *assignmentservice.ExportAssignmentsRequest, stream grpc.ServerStreamingServer[assignmentservice.Assignment]) error {
+	ctx, span := h.tracer.Start(stream.Context(), "GrpcHandler.ExportAssignments")
+	defer span.End()
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	assignments, serviceErr := h.service.GetAssignments(ctx, userID)
+	if serviceErr != nil {
+		return grpcStatusError(serviceErr)
+	}
+
+	if req.IncludeTrashed {
+		trashed, serviceErr := h.service.GetTrashedAssignments(ctx, userID)
+		if serviceErr != nil {
+			return grpcStatusError(serviceErr)
+		}
+		assignments = append(assignments, trashed...)
+	}
+
+	for _, assignment := range assignments {
+		if err := stream.Send(toProtoAssignment(assignment)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *GrpcHandler) PublishUserEvent(ctx context.Context, req *assignmentservice.PublishUserEventRequest) (*assignmentservice.PublishUserEventResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "GrpcHandler.PublishUserEvent")
+	defer span.End()
+
+	res := &assignmentservice.PublishUserEventResponse{}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return res, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	if serviceErr := h.service.PublishUserEvent(ctx, userID, app.WebhookEvent(req.Event), req.Payload); serviceErr != nil {
+		return res, grpcStatusError(serviceErr)
+	}
+
+	return res, nil
+}
+
+func grpcStatusError(serviceErr app.ServiceError) error {
+	var code codes.Code
+	switch serviceErr.Code() {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		code = codes.InvalidArgument
+	case http.StatusUnauthorized:
+		code = codes.Unauthenticated
+	case http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		code = codes.FailedPrecondition
+	default:
+		code = codes.Internal
+	}
+
+	return status.Error(code, serviceErr.Error())
+}
+
+func toProtoAssignment(assignment app.Assignment) *assignmentservice.Assignment {
+	res := &assignmentservice.Assignment{
+		Id:          assignment.ID,
+		UserId:      assignment.UserID.String(),
+		Title:       assignment.Title,
+		DueDate:     toProtoTimestamp(assignment.DueDate),
+		CompletedAt: toProtoTimestamp(assignment.CompletedAt),
+		IsBlocked:   assignment.IsBlocked,
+		Version:     assignment.Version,
+		CreatedAt:   toProtoTimestamp(assignment.CreatedAt),
+		UpdatedAt:   toProtoTimestamp(assignment.UpdatedAt),
+		DeletedAt:   toProtoTimestamp(assignment.DeletedAt),
+		Reminders:   make([]*assignmentservice.Reminder, len(assignment.Reminders)),
+	}
+	if assignment.Note != nil {
+		res.Note = *assignment.Note
+	}
+	if assignment.IsCompleted != nil {
+		res.IsCompleted = *assignment.IsCompleted
+	}
+	if assignment.IsImportant != nil {
+		res.IsImportant = *assignment.IsImportant
+	}
+
+	for i, reminder := range assignment.Reminders {
+		res.Reminders[i] = &assignmentservice.Reminder{
+			Id:               reminder.ID,
+			Date:             timestamppb.New(reminder.Date),
+			BeforeDueMinutes: reminder.BeforeDueMinutes,
+		}
+	}
+
+	return res
+}
+
+func toProtoTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -40,6 +40,7 @@ func NewHttpHandler(
 
 func (h *HttpHandler) setupRoutes(c *chi.Mux) http.Handler {
 	c.Get("/health", h.HealthCheck)
+	c.Get("/calendar/{token}", h.ServeCalendarFeed)
 
 	c.Group(func(c chi.Router) {
 		c.Use(h.authMiddleware.Auth)
@@ -48,8 +49,73 @@ func (h *HttpHandler) setupRoutes(c *chi.Mux) http.Handler {
 		c.Get("/", h.GetAssignments)
 		c.Get("/{id}", h.GetAssignmentByID)
 		c.Put("/{id}", h.UpdateAssignmentByID)
+		c.Patch("/{id}", h.PatchAssignmentByID)
 		c.Delete("/{id}", h.DeleteAssignmentByID)
 		c.Post("/change-status", h.ChangeIsCompletedByID)
+		c.Post("/import", h.ImportAssignments)
+		c.Post("/quick", h.QuickAddAssignment)
+		c.Post("/batch", h.BatchAssignments)
+		c.Get("/search", h.SearchAssignments)
+		c.Get("/stats", h.GetAssignmentStats)
+		c.Get("/trash", h.GetTrashedAssignments)
+		c.Post("/{id}/restore", h.RestoreAssignmentByID)
+		c.Get("/{id}/history", h.GetAssignmentHistory)
+		c.Post("/{id}/undo", h.UndoAssignmentChange)
+		c.Get("/{id}/tags", h.GetAssignmentTags)
+		c.Put("/{id}/tags", h.UpdateAssignmentTags)
+		c.Get("/{id}/dependencies", h.GetAssignmentDependencies)
+		c.Post("/{id}/dependencies", h.AddAssignmentDependency)
+		c.Delete("/{id}/dependencies/{blockedByID}", h.RemoveAssignmentDependency)
+		c.Get("/next", h.GetNextAssignments)
+		c.Post("/{id}/focus-sessions", h.StartFocusSession)
+		c.Get("/{id}/focus-sessions", h.GetFocusSessions)
+		c.Get("/focus-sessions/current", h.GetCurrentFocusSession)
+		c.Get("/focus-sessions/totals", h.GetFocusTotals)
+		c.Post("/focus-sessions/{sessionID}/stop", h.StopFocusSession)
+		c.Post("/{id}/reminders/{reminderID}/snooze", h.SnoozeReminder)
+		c.Get("/quiet-hours", h.GetQuietHours)
+		c.Put("/quiet-hours", h.UpdateQuietHours)
+		c.Delete("/quiet-hours", h.DeleteQuietHours)
+		c.Post("/templates", h.CreateAssignmentTemplate)
+		c.Get("/templates", h.GetAssignmentTemplates)
+		c.Get("/templates/{id}", h.GetAssignmentTemplateByID)
+		c.Put("/templates/{id}", h.UpdateAssignmentTemplateByID)
+		c.Delete("/templates/{id}", h.DeleteAssignmentTemplateByID)
+		c.Post("/from-template/{id}", h.CreateAssignmentFromTemplate)
+		c.Post("/{id}/attachments", h.UploadAttachment)
+		c.Get("/{id}/attachments", h.GetAttachments)
+		c.Delete("/{id}/attachments/{attachmentID}", h.DeleteAttachmentByID)
+		c.Get("/{id}/collaborators", h.GetCollaborators)
+		c.Post("/{id}/collaborators", h.InviteCollaborator)
+		c.Put("/{id}/collaborators/{userID}", h.UpdateCollaboratorRole)
+		c.Delete("/{id}/collaborators/{userID}", h.RemoveCollaborator)
+		c.Get("/invitations", h.GetInvitations)
+		c.Post("/invitations/{assignmentID}/accept", h.AcceptInvitation)
+		c.Delete("/invitations/{assignmentID}", h.DeclineInvitation)
+		c.Post("/workspaces", h.CreateWorkspace)
+		c.Get("/workspaces", h.GetWorkspaces)
+		c.Get("/workspaces/{id}", h.GetWorkspaceByID)
+		c.Delete("/workspaces/{id}", h.DeleteWorkspaceByID)
+		c.Post("/workspaces/{id}/members", h.AddWorkspaceMember)
+		c.Delete("/workspaces/{id}/members/{userID}", h.RemoveWorkspaceMember)
+		c.Post("/workspaces/{id}/assignments", h.PublishWorkspaceAssignment)
+		c.Get("/workspaces/{id}/assignments", h.GetWorkspaceAssignments)
+		c.Get("/workspaces/{id}/progress", h.GetWorkspaceProgress)
+		c.Get("/calendar-feed", h.GetCalendarFeed)
+		c.Post("/calendar-feed", h.RotateCalendarFeed)
+		c.Delete("/calendar-feed", h.RevokeCalendarFeed)
+		c.Post("/webhooks", h.CreateWebhook)
+		c.Get("/webhooks", h.GetWebhooks)
+		c.Get("/webhooks/{id}", h.GetWebhookByID)
+		c.Put("/webhooks/{id}", h.UpdateWebhookByID)
+		c.Delete("/webhooks/{id}", h.DeleteWebhookByID)
+		c.Get("/webhooks/{id}/deliveries", h.GetWebhookDeliveries)
+		c.Post("/webhooks/{id}/deliveries/{deliveryID}/redeliver", h.RedeliverWebhookDelivery)
+
+		c.Get("/events", h.StreamUserEvents)
+
+		c.Get("/sync", h.SyncChanges)
+		c.Post("/sync", h.UploadSyncChanges)
 	})
 
 	return c
@@ -132,9 +198,11 @@ func (h *HttpHandler) GetAssignmentByID(w http.ResponseWriter, r *http.Request)
 	assignment, serviceErr := h.service.GetAssignmentByID(ctx, uuid.MustParse(userID), int32(assignmentID))
 	if serviceErr != nil {
 		code := serviceErr.Code()
-		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), nil, serviceErr)
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
 	}
 
+	w.Header().Set("ETag", assignment.ETag())
 	h.responseWriter.WriteSuccessResponse(ctx, w, assignment)
 }
 
@@ -154,9 +222,10 @@ func (h *HttpHandler) DeleteAssignmentByID(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if serviceErr := h.service.DeleteAssignmentByID(ctx, uuid.MustParse(userID), int32(assignmentID)); serviceErr != nil {
+	if serviceErr := h.service.DeleteAssignmentByID(ctx, uuid.MustParse(userID), int32(assignmentID), r.Header.Get("If-Match")); serviceErr != nil {
 		code := serviceErr.Code()
-		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), nil, serviceErr)
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
 	}
 
 	h.responseWriter.WriteSuccessResponse(ctx, w, nil)
@@ -189,12 +258,14 @@ func (h *HttpHandler) UpdateAssignmentByID(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	assignment, serviceErr := h.service.UpdateAssignmentByID(ctx, uuid.MustParse(userID), int32(assignmentID), request)
+	assignment, serviceErr := h.service.UpdateAssignmentByID(ctx, uuid.MustParse(userID), int32(assignmentID), r.Header.Get("If-Match"), request)
 	if serviceErr != nil {
 		code := serviceErr.Code()
-		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), nil, serviceErr)
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
 	}
 
+	w.Header().Set("ETag", assignment.ETag())
 	h.responseWriter.WriteSuccessResponse(ctx, w, assignment)
 }
 
@@ -219,10 +290,10 @@ func (h *HttpHandler) ChangeIsCompletedByID(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	assignment, serviceErr := h.service.ChangeIsCompletedByID(ctx, uuid.MustParse(userID), int32(request.ID), request.IsCompleted)
+	assignment, serviceErr := h.service.ChangeIsCompletedByID(ctx, uuid.MustParse(userID), int32(request.ID), request.IsCompleted, request.Force)
 	if serviceErr != nil {
 		code := serviceErr.Code()
-		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), nil, serviceErr)
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
 		return
 	}
 
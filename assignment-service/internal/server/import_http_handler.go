@@ -0,0 +1,83 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/google/uuid"
+)
+
+const importMaxSize = 5 << 20
+
+func (h *HttpHandler) ImportAssignments(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.ImportAssignments")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, importMaxSize)
+	fileMultipart, fileHeader, err := r.FormFile("file")
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		h.responseWriter.WriteErrorResponse(ctx, w, http.StatusRequestEntityTooLarge, http.StatusText(http.StatusRequestEntityTooLarge))
+		return
+	}
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+	defer fileMultipart.Close()
+
+	file, err := io.ReadAll(fileMultipart)
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	request := app.ImportRequest{
+		Format:   app.ImportFormat(strings.ToLower(r.FormValue("format"))),
+		FileName: fileHeader.Filename,
+		File:     file,
+	}
+
+	if mapping := r.FormValue("mapping"); mapping != "" {
+		if err := json.Unmarshal([]byte(mapping), &request.Mapping); err != nil {
+			h.responseWriter.WriteBadRequestResponse(ctx, w)
+			return
+		}
+	}
+
+	if dryRun := r.FormValue("dry_run"); dryRun != "" {
+		if request.DryRun, err = strconv.ParseBool(dryRun); err != nil {
+			h.responseWriter.WriteBadRequestResponse(ctx, w)
+			return
+		}
+	}
+
+	if timezone := r.FormValue("timezone"); timezone != "" {
+		if request.Location, err = time.LoadLocation(timezone); err != nil {
+			h.responseWriter.WriteBadRequestResponse(ctx, w)
+			return
+		}
+	}
+
+	result, serviceErr := h.service.ImportAssignments(ctx, uuid.MustParse(userID), request)
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, result)
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/google/uuid"
+)
+
+func (h *HttpHandler) QuickAddAssignment(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.QuickAddAssignment")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	var request app.QuickAddRequest
+	if err := h.requestDecoder.Decode(ctx, r, &request); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if preview := r.URL.Query().Get("preview"); preview != "" {
+		if request.Preview, err = strconv.ParseBool(preview); err != nil {
+			h.responseWriter.WriteBadRequestResponse(ctx, w)
+			return
+		}
+	}
+
+	if err := h.validator.Validate(ctx, request); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	result, serviceErr := h.service.QuickAddAssignment(ctx, uuid.MustParse(userID), request)
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	if request.Preview {
+		h.responseWriter.WriteSuccessResponse(ctx, w, result)
+		return
+	}
+
+	h.responseWriter.WriteJSONResponse(ctx, w, http.StatusCreated, http.StatusText(http.StatusCreated), result)
+}
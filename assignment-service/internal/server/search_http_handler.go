@@ -0,0 +1,37 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/google/uuid"
+)
+
+func (h *HttpHandler) SearchAssignments(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.SearchAssignments")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	var limit int
+	if rawLimit := r.URL.Query().Get("limit"); rawLimit != "" {
+		if limit, err = strconv.Atoi(rawLimit); err != nil {
+			h.responseWriter.WriteBadRequestResponse(ctx, w)
+			return
+		}
+	}
+
+	results, serviceErr := h.service.SearchAssignments(ctx, uuid.MustParse(userID), r.URL.Query().Get("q"), limit)
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, results)
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sync"
 
 	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/authservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/monitoring"
 	"github.com/MasLazu/dev-ops-porto/pkg/util"
@@ -18,7 +20,7 @@ func Run(ctx context.Context) error {
 	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt)
 	defer cancel()
 
-	config, err := getConfig()
+	config, err := getConfig(ctx)
 	if err != nil {
 		return err
 	}
@@ -57,7 +59,43 @@ func Run(ctx context.Context) error {
 
 	missionServiceClient := missionservice.NewMissionServiceClient(missionServiceConn)
 
-	httpServer := bootstrap(config, db, logger, missionServiceClient)
+	authServiceConn, err := util.NewGRPCClient(ctx, config.grpcAuthServiceDomain, logger)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("Failed to connect to gRPC server: %v", err), log.String("address", config.grpcAuthServiceDomain))
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, authServiceConn.Close())
+	}()
+
+	authServiceClient := authservice.NewAuthServiceClient(authServiceConn)
+
+	httpServer, grpcServer, trashPurger, webhookDispatcher, userEventListener := bootstrap(config, db, logger, missionServiceClient, authServiceClient)
+
+	go trashPurger.Run(ctx)
+	go webhookDispatcher.Run(ctx)
+	go userEventListener.Run(ctx)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		if httpErr := httpServer.Run(ctx); httpErr != nil {
+			logger.Error(ctx, fmt.Sprintf("Failed to run HTTP server: %v", httpErr))
+			err = errors.Join(err, httpErr)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		if grpcErr := grpcServer.Run(ctx); grpcErr != nil {
+			logger.Error(ctx, fmt.Sprintf("Failed to run GRPC server: %v", grpcErr))
+			err = errors.Join(err, grpcErr)
+		}
+	}()
+
+	wg.Wait()
 
-	return httpServer.Run(ctx)
+	return err
 }
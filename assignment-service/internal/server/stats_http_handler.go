@@ -0,0 +1,37 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/google/uuid"
+)
+
+func (h *HttpHandler) GetAssignmentStats(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.GetAssignmentStats")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	var location *time.Location
+	if timezone := r.URL.Query().Get("timezone"); timezone != "" {
+		if location, err = time.LoadLocation(timezone); err != nil {
+			h.responseWriter.WriteBadRequestResponse(ctx, w)
+			return
+		}
+	}
+
+	stats, serviceErr := h.service.GetAssignmentStats(ctx, uuid.MustParse(userID), location)
+	if serviceErr != nil {
+		code := serviceErr.Code()
+		h.responseWriter.WriteJSONResponseWithInternalError(ctx, w, code, http.StatusText(code), serviceErr.Data(), serviceErr)
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, stats)
+}
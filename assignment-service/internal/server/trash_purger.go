@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/monitoring"
+	"go.opentelemetry.io/otel/log"
+)
+
+const trashPurgeInterval = time.Hour
+
+type TrashPurger struct {
+	service   *app.Service
+	retention time.Duration
+	logger    *monitoring.Logger
+}
+
+func NewTrashPurger(service *app.Service, retention time.Duration, logger *monitoring.Logger) *TrashPurger {
+	return &TrashPurger{
+		service:   service,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *TrashPurger) purge(ctx context.Context) {
+	purged, err := p.service.PurgeTrash(ctx, p.retention)
+	if err != nil {
+		p.logger.Error(ctx, fmt.Sprintf("Failed to purge trash: %v", err), log.Int("purged", purged))
+		return
+	}
+
+	if purged > 0 {
+		p.logger.Info(ctx, "Purged trashed assignments", log.Int("purged", purged))
+	}
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/google/uuid"
+)
+
+const (
+	userEventHeartbeatInterval = 25 * time.Second
+	userEventRetry             = 3 * time.Second
+)
+
+func (h *HttpHandler) StreamUserEvents(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.StreamUserEvents")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	events, unsubscribe := h.service.SubscribeUserEvents(uuid.MustParse(userID))
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	controller := http.NewResponseController(w)
+	fmt.Fprintf(w, "retry: %d\n\n", userEventRetry.Milliseconds())
+	if err := controller.Flush(); err != nil {
+		span.RecordError(err)
+		return
+	}
+
+	heartbeat := time.NewTicker(userEventHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			data, err := json.Marshal(event.Data)
+			if err != nil {
+				span.RecordError(err)
+				continue
+			}
+
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Event, data)
+		}
+
+		if err := controller.Flush(); err != nil {
+			return
+		}
+	}
+}
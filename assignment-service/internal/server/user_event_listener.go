@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/monitoring"
+)
+
+const userEventListenRetryDelay = 5 * time.Second
+
+type UserEventListener struct {
+	service *app.Service
+	logger  *monitoring.Logger
+}
+
+func NewUserEventListener(service *app.Service, logger *monitoring.Logger) *UserEventListener {
+	return &UserEventListener{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (l *UserEventListener) Run(ctx context.Context) {
+	defer l.service.CloseUserEventSubscribers()
+
+	for {
+		err := l.service.ListenUserEvents(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		l.logger.Error(ctx, fmt.Sprintf("Failed to listen for user events: %v", err))
+		l.service.DisconnectUserEventSubscribers()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(userEventListenRetryDelay):
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/assignment-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/monitoring"
+	"go.opentelemetry.io/otel/log"
+)
+
+const webhookDispatchInterval = 10 * time.Second
+
+type WebhookDispatcher struct {
+	service *app.Service
+	logger  *monitoring.Logger
+}
+
+func NewWebhookDispatcher(service *app.Service, logger *monitoring.Logger) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(webhookDispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		d.fireReminders(ctx)
+		d.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *WebhookDispatcher) fireReminders(ctx context.Context) {
+	fired, err := d.service.FireDueReminders(ctx)
+	if err != nil {
+		d.logger.Error(ctx, fmt.Sprintf("Failed to fire due reminders: %v", err))
+		return
+	}
+
+	if fired > 0 {
+		d.logger.Info(ctx, "Fired due reminders", log.Int("fired", fired))
+	}
+}
+
+func (d *WebhookDispatcher) dispatch(ctx context.Context) {
+	for ctx.Err() == nil {
+		dispatched, err := d.service.DispatchWebhookDeliveries(ctx)
+		if err != nil {
+			d.logger.Error(ctx, fmt.Sprintf("Failed to dispatch webhook deliveries: %v", err), log.Int("dispatched", dispatched))
+			return
+		}
+
+		if dispatched == 0 {
+			return
+		}
+
+		d.logger.Info(ctx, "Dispatched webhook deliveries", log.Int("dispatched", dispatched))
+	}
+}
@@ -0,0 +1,6 @@
+CREATE TABLE calendar_feeds (
+    user_id UUID PRIMARY KEY,
+    token VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS assignments_deleted_at_idx;
+
+ALTER TABLE assignments DROP COLUMN IF EXISTS deleted_at;
\ No newline at end of file
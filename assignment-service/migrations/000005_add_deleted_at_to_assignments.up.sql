@@ -0,0 +1,3 @@
+ALTER TABLE assignments ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX assignments_deleted_at_idx ON assignments (deleted_at) WHERE deleted_at IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS assignment_collaborators;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE assignment_collaborators (
+    id SERIAL PRIMARY KEY,
+    assignment_id INTEGER NOT NULL REFERENCES assignments (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role VARCHAR(16) NOT NULL CHECK (role IN ('viewer', 'editor', 'owner')),
+    invited_by UUID NOT NULL,
+    accepted_at TIMESTAMPTZ,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (assignment_id, user_id)
+);
+
+CREATE INDEX assignment_collaborators_user_id_idx ON assignment_collaborators (user_id);
\ No newline at end of file
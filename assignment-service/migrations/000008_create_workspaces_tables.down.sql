@@ -0,0 +1,7 @@
+ALTER TABLE assignments DROP COLUMN IF EXISTS workspace_assignment_id;
+
+DROP TABLE IF EXISTS workspace_assignments;
+
+DROP TABLE IF EXISTS workspace_members;
+
+DROP TABLE IF EXISTS workspaces;
\ No newline at end of file
@@ -0,0 +1,33 @@
+CREATE TABLE workspaces (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    owner_id UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE workspace_members (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role VARCHAR(16) NOT NULL CHECK (role IN ('owner', 'member')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (workspace_id, user_id)
+);
+
+CREATE INDEX workspace_members_user_id_idx ON workspace_members (user_id);
+
+CREATE TABLE workspace_assignments (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    published_by UUID NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    note TEXT,
+    due_date TIMESTAMPTZ,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE assignments ADD COLUMN workspace_assignment_id INTEGER REFERENCES workspace_assignments (id) ON DELETE SET NULL;
+
+CREATE INDEX assignments_workspace_assignment_id_idx ON assignments (workspace_assignment_id);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS assignment_changes;
\ No newline at end of file
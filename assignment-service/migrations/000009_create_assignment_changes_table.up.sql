@@ -0,0 +1,11 @@
+CREATE TABLE assignment_changes (
+    id SERIAL PRIMARY KEY,
+    assignment_id INTEGER NOT NULL REFERENCES assignments (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    action VARCHAR(16) NOT NULL CHECK (action IN ('create', 'update', 'complete', 'uncomplete', 'delete', 'restore', 'undo')),
+    changes JSONB NOT NULL DEFAULT '{}',
+    version INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX assignment_changes_assignment_id_idx ON assignment_changes (assignment_id, id);
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE assignments ADD COLUMN completed_at TIMESTAMPTZ;
+
+UPDATE assignments SET completed_at = updated_at WHERE is_completed;
+
+CREATE INDEX assignments_user_id_completed_at_idx ON assignments (user_id, completed_at) WHERE completed_at IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS assignments_search_vector_idx;
+
+ALTER TABLE assignments DROP COLUMN IF EXISTS search_vector;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE assignments ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(note, '')), 'B')
+) STORED;
+
+CREATE INDEX assignments_search_vector_idx ON assignments USING GIN (search_vector);
\ No newline at end of file
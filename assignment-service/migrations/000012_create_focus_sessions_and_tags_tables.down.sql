@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS assignment_tags;
+
+DROP TABLE IF EXISTS focus_sessions;
\ No newline at end of file
@@ -0,0 +1,25 @@
+CREATE TABLE focus_sessions (
+    id SERIAL PRIMARY KEY,
+    assignment_id INTEGER NOT NULL REFERENCES assignments (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('timer', 'pomodoro')),
+    planned_duration_seconds INTEGER CHECK (planned_duration_seconds > 0),
+    started_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ended_at TIMESTAMPTZ,
+    duration_seconds INTEGER,
+    completed BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX focus_sessions_running_user_id_idx ON focus_sessions (user_id) WHERE ended_at IS NULL;
+
+CREATE INDEX focus_sessions_user_id_started_at_idx ON focus_sessions (user_id, started_at);
+
+CREATE TABLE assignment_tags (
+    assignment_id INTEGER NOT NULL REFERENCES assignments (id) ON DELETE CASCADE,
+    tag VARCHAR(64) NOT NULL,
+    PRIMARY KEY (assignment_id, tag)
+);
+
+CREATE INDEX assignment_tags_tag_idx ON assignment_tags (tag);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS quiet_hours;
+
+ALTER TABLE reminders DROP COLUMN IF EXISTS before_due_minutes;
\ No newline at end of file
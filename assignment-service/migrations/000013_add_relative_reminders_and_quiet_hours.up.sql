@@ -0,0 +1,10 @@
+ALTER TABLE reminders ADD COLUMN before_due_minutes INTEGER CHECK (before_due_minutes >= 0);
+
+CREATE TABLE quiet_hours (
+    user_id UUID PRIMARY KEY,
+    starts_at VARCHAR(5) NOT NULL CHECK (starts_at ~ '^([01][0-9]|2[0-3]):[0-5][0-9]$'),
+    ends_at VARCHAR(5) NOT NULL CHECK (ends_at ~ '^([01][0-9]|2[0-3]):[0-5][0-9]$'),
+    timezone VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
\ No newline at end of file
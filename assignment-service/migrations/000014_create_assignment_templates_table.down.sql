@@ -0,0 +1 @@
+DROP TABLE IF EXISTS assignment_templates;
\ No newline at end of file
@@ -0,0 +1,18 @@
+CREATE TABLE assignment_templates (
+    id SERIAL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    workspace_id INTEGER REFERENCES workspaces (id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    title_pattern VARCHAR(255) NOT NULL,
+    note TEXT,
+    is_important BOOLEAN NOT NULL DEFAULT FALSE,
+    reminder_offsets JSONB NOT NULL DEFAULT '[]',
+    tags JSONB NOT NULL DEFAULT '[]',
+    use_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX assignment_templates_user_id_idx ON assignment_templates (user_id);
+
+CREATE INDEX assignment_templates_workspace_id_idx ON assignment_templates (workspace_id);
\ No newline at end of file
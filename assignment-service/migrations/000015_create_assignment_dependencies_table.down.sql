@@ -0,0 +1 @@
+DROP TABLE IF EXISTS assignment_dependencies;
\ No newline at end of file
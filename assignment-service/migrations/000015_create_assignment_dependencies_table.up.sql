@@ -0,0 +1,9 @@
+CREATE TABLE assignment_dependencies (
+    assignment_id INTEGER NOT NULL REFERENCES assignments (id) ON DELETE CASCADE,
+    blocked_by_id INTEGER NOT NULL REFERENCES assignments (id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (assignment_id, blocked_by_id),
+    CHECK (assignment_id <> blocked_by_id)
+);
+
+CREATE INDEX assignment_dependencies_blocked_by_id_idx ON assignment_dependencies (blocked_by_id);
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE reminders DROP COLUMN IF EXISTS fired_at;
+
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DROP TABLE IF EXISTS webhooks;
\ No newline at end of file
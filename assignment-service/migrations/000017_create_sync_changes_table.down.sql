@@ -0,0 +1,13 @@
+DROP TRIGGER IF EXISTS assignment_collaborators_sync_changes ON assignment_collaborators;
+
+DROP TRIGGER IF EXISTS reminders_sync_changes ON reminders;
+
+DROP TRIGGER IF EXISTS assignments_sync_changes ON assignments;
+
+DROP FUNCTION IF EXISTS record_collaborator_sync_change;
+
+DROP FUNCTION IF EXISTS record_reminder_sync_change;
+
+DROP FUNCTION IF EXISTS record_assignment_sync_change;
+
+DROP TABLE IF EXISTS sync_changes;
\ No newline at end of file
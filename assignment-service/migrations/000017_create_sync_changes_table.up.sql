@@ -0,0 +1,77 @@
+CREATE TABLE sync_changes (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id BIGINT NOT NULL DEFAULT pg_current_xact_id()::text::bigint,
+    entity VARCHAR(16) NOT NULL CHECK (entity IN ('assignment', 'reminder')),
+    entity_id INTEGER NOT NULL,
+    assignment_id INTEGER NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX sync_changes_user_id_idx ON sync_changes (user_id, transaction_id);
+CREATE INDEX sync_changes_assignment_id_idx ON sync_changes (assignment_id, transaction_id);
+
+CREATE FUNCTION record_assignment_sync_change() RETURNS TRIGGER AS $$
+DECLARE
+    assignment assignments%ROWTYPE;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        assignment := OLD;
+    ELSE
+        assignment := NEW;
+    END IF;
+
+    INSERT INTO sync_changes (entity, entity_id, assignment_id, user_id)
+    VALUES ('assignment', assignment.id, assignment.id, assignment.user_id);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION record_reminder_sync_change() RETURNS TRIGGER AS $$
+DECLARE
+    reminder reminders%ROWTYPE;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        reminder := OLD;
+    ELSE
+        reminder := NEW;
+    END IF;
+
+    INSERT INTO sync_changes (entity, entity_id, assignment_id, user_id)
+    SELECT 'reminder', reminder.id, assignments.id, assignments.user_id
+    FROM assignments
+    WHERE assignments.id = reminder.assignment_id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION record_collaborator_sync_change() RETURNS TRIGGER AS $$
+DECLARE
+    collaborator assignment_collaborators%ROWTYPE;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        collaborator := OLD;
+    ELSE
+        collaborator := NEW;
+    END IF;
+
+    INSERT INTO sync_changes (entity, entity_id, assignment_id, user_id)
+    VALUES ('assignment', collaborator.assignment_id, collaborator.assignment_id, collaborator.user_id);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER assignments_sync_changes
+    AFTER INSERT OR DELETE OR UPDATE OF user_id, title, note, due_date, is_completed, is_important, deleted_at, version, completed_at ON assignments
+    FOR EACH ROW EXECUTE FUNCTION record_assignment_sync_change();
+
+CREATE TRIGGER reminders_sync_changes
+    AFTER INSERT OR DELETE OR UPDATE OF assignment_id, date, before_due_minutes ON reminders
+    FOR EACH ROW EXECUTE FUNCTION record_reminder_sync_change();
+
+CREATE TRIGGER assignment_collaborators_sync_changes
+    AFTER INSERT OR DELETE OR UPDATE OF role, accepted_at ON assignment_collaborators
+    FOR EACH ROW EXECUTE FUNCTION record_collaborator_sync_change();
\ No newline at end of file
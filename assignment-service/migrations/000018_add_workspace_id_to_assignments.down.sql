@@ -0,0 +1 @@
+ALTER TABLE assignments DROP COLUMN IF EXISTS workspace_id;
\ No newline at end of file
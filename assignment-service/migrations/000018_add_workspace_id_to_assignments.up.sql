@@ -0,0 +1,3 @@
+ALTER TABLE assignments ADD COLUMN workspace_id INTEGER REFERENCES workspaces (id) ON DELETE SET NULL;
+
+CREATE INDEX assignments_workspace_id_idx ON assignments (workspace_id);
\ No newline at end of file
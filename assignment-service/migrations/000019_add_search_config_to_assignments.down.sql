@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS assignments_search_vector_idx;
+
+ALTER TABLE assignments DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE assignments DROP COLUMN IF EXISTS search_config;
+
+ALTER TABLE assignments ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(note, '')), 'B')
+) STORED;
+
+CREATE INDEX assignments_search_vector_idx ON assignments USING GIN (search_vector);
\ No newline at end of file
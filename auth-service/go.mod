@@ -3,7 +3,7 @@ module github.com/MasLazu/dev-ops-porto/auth-service
 go 1.23.2
 
 require (
-	github.com/MasLazu/dev-ops-porto/pkg v1.8.6
+	github.com/MasLazu/dev-ops-porto/pkg v1.16.0
 	github.com/aws/aws-sdk-go-v2 v1.32.3
 	github.com/aws/aws-sdk-go-v2/config v1.28.1
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.42
@@ -72,3 +72,5 @@ require (
 	google.golang.org/genproto/googleapis/api v0.0.0-20241021214115-324edc3d5d38 // indirect
 	google.golang.org/protobuf v1.35.1 // indirect
 )
+
+replace github.com/MasLazu/dev-ops-porto/pkg => ../pkg
@@ -2,12 +2,16 @@ package app
 
 import "time"
 
+const coinsUpdatedEvent = "coins.updated"
+
 type user struct {
 	ID             string    `json:"id"`
 	Email          string    `json:"email"`
 	Name           string    `json:"name"`
 	Coin           int       `json:"coin"`
 	ProfilePicture *string   `json:"profile_picture,omitempty"`
+	Timezone       string    `json:"timezone"`
+	Locale         string    `json:"locale"`
 	Password       string    `json:"-"`
 	CreatedAt      time.Time `json:"created_at"`
 	UpdatedAt      time.Time `json:"updated_at"`
@@ -20,6 +24,11 @@ func (u *user) addPrefixToProfilePictureURL(prefix string) {
 	*u.ProfilePicture = prefix + *u.ProfilePicture
 }
 
+type coinsUpdatedPayload struct {
+	Coin  int   `json:"coin"`
+	Delta int32 `json:"delta"`
+}
+
 type RegisterUserRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 	Name     string `json:"name" validate:"required"`
@@ -42,3 +51,8 @@ type LoginUserRequest struct {
 type LoginResponse struct {
 	AccessToken string `json:"access_token"`
 }
+
+type UpdatePreferencesRequest struct {
+	Timezone string `json:"timezone" validate:"required,timezone"`
+	Locale   string `json:"locale" validate:"required,bcp47_language_tag"`
+}
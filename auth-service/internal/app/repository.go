@@ -28,7 +28,7 @@ func (r *Repository) FindUserByEmail(ctx context.Context, email string) (user, e
 	defer span.End()
 
 	query := `
-    SELECT id, email, name, coin, profile_picture, created_at, updated_at, password
+    SELECT id, email, name, coin, profile_picture, timezone, locale, created_at, updated_at, password
     FROM users 
     WHERE email = $1
     `
@@ -36,7 +36,7 @@ func (r *Repository) FindUserByEmail(ctx context.Context, email string) (user, e
 
 	var u user
 	var profilePicture sql.NullString
-	err := row.Scan(&u.ID, &u.Email, &u.Name, &u.Coin, &profilePicture, &u.CreatedAt, &u.UpdatedAt, &u.Password)
+	err := row.Scan(&u.ID, &u.Email, &u.Name, &u.Coin, &profilePicture, &u.Timezone, &u.Locale, &u.CreatedAt, &u.UpdatedAt, &u.Password)
 	if err != nil {
 		return u, err
 	}
@@ -53,7 +53,7 @@ func (r *Repository) FindUserByID(ctx context.Context, userID string) (user, err
 	defer span.End()
 
 	query := `
-	SELECT id, email, name, coin, profile_picture, created_at, updated_at, password
+	SELECT id, email, name, coin, profile_picture, timezone, locale, created_at, updated_at, password
 	FROM users 
 	WHERE id = $1
 	`
@@ -61,7 +61,7 @@ func (r *Repository) FindUserByID(ctx context.Context, userID string) (user, err
 
 	var u user
 	var profilePicture sql.NullString
-	err := row.Scan(&u.ID, &u.Email, &u.Name, &u.Coin, &profilePicture, &u.CreatedAt, &u.UpdatedAt, &u.Password)
+	err := row.Scan(&u.ID, &u.Email, &u.Name, &u.Coin, &profilePicture, &u.Timezone, &u.Locale, &u.CreatedAt, &u.UpdatedAt, &u.Password)
 	if err != nil {
 		return u, err
 	}
@@ -81,13 +81,13 @@ func (r *Repository) UpdateUser(ctx context.Context, u user) (user, error) {
 	UPDATE users
 	SET name = $1, coin = $2, profile_picture = $3, updated_at = NOW()
 	WHERE id = $4
-	RETURNING id, email, name, coin, profile_picture, created_at, updated_at
+	RETURNING id, email, name, coin, profile_picture, timezone, locale, created_at, updated_at
 	`
 
 	var updatedUser user
 	var profilePicture sql.NullString
 	err := r.db.Pool.QueryRowContext(ctx, query, u.Name, u.Coin, u.ProfilePicture, u.ID).Scan(
-		&updatedUser.ID, &updatedUser.Email, &updatedUser.Name, &updatedUser.Coin, &profilePicture, &updatedUser.CreatedAt, &updatedUser.UpdatedAt)
+		&updatedUser.ID, &updatedUser.Email, &updatedUser.Name, &updatedUser.Coin, &profilePicture, &updatedUser.Timezone, &updatedUser.Locale, &updatedUser.CreatedAt, &updatedUser.UpdatedAt)
 	if err != nil {
 		return updatedUser, err
 	}
@@ -106,12 +106,12 @@ func (r *Repository) InsertUser(ctx context.Context, u user) (user, error) {
 	query := `
     INSERT INTO users (id, email, name, password)
     VALUES ($1, $2, $3, $4)
-    RETURNING id, email, name, coin, created_at, updated_at
+    RETURNING id, email, name, coin, timezone, locale, created_at, updated_at
     `
 
 	orderID := uuid.New().String()
 	err := r.db.Pool.QueryRowContext(ctx, query, orderID, u.Email, u.Name, u.Password).Scan(
-		&u.ID, &u.Email, &u.Name, &u.Coin, &u.CreatedAt, &u.UpdatedAt)
+		&u.ID, &u.Email, &u.Name, &u.Coin, &u.Timezone, &u.Locale, &u.CreatedAt, &u.UpdatedAt)
 	if err != nil {
 		return u, err
 	}
@@ -119,6 +119,32 @@ func (r *Repository) InsertUser(ctx context.Context, u user) (user, error) {
 	return u, nil
 }
 
+func (r *Repository) UpdateUserPreferences(ctx context.Context, userID string, timezone string, locale string) (user, error) {
+	ctx, span := r.tracer.Start(ctx, "Repository.UpdateUserPreferences")
+	defer span.End()
+
+	query := `
+	UPDATE users
+	SET timezone = $1, locale = $2, updated_at = NOW()
+	WHERE id = $3
+	RETURNING id, email, name, coin, profile_picture, timezone, locale, created_at, updated_at
+	`
+
+	var u user
+	var profilePicture sql.NullString
+	err := r.db.Pool.QueryRowContext(ctx, query, timezone, locale, userID).Scan(
+		&u.ID, &u.Email, &u.Name, &u.Coin, &profilePicture, &u.Timezone, &u.Locale, &u.CreatedAt, &u.UpdatedAt)
+	if err != nil {
+		return u, err
+	}
+
+	if profilePicture.Valid {
+		u.ProfilePicture = &profilePicture.String
+	}
+
+	return u, nil
+}
+
 func (r *Repository) DeleteUserProfilePicture(ctx context.Context, userID string) error {
 	ctx, span := r.tracer.Start(ctx, "Repository.DeleteUserProfilePicture")
 	defer span.End()
@@ -3,11 +3,13 @@ package app
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/MasLazu/dev-ops-porto/pkg/errors"
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/assignmentservice"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/golang-jwt/jwt/v5"
 	"go.opentelemetry.io/otel/trace"
@@ -16,12 +18,13 @@ import (
 )
 
 type Service struct {
-	tracer                trace.Tracer
-	repository            *Repository
-	jwtSecret             []byte
-	client                *s3.Client
-	profilePicturesBucket string
-	staticServiceEnpoint  string
+	tracer                  trace.Tracer
+	repository              *Repository
+	jwtSecret               []byte
+	client                  *s3.Client
+	profilePicturesBucket   string
+	staticServiceEnpoint    string
+	assignmentServiceClient assignmentservice.AssignmentServiceClient
 }
 
 func NewService(
@@ -31,14 +34,16 @@ func NewService(
 	client *s3.Client,
 	profilePicturesBucket string,
 	staticServiceEnpoint string,
+	assignmentServiceClient assignmentservice.AssignmentServiceClient,
 ) *Service {
 	return &Service{
-		tracer:                tracer,
-		repository:            repository,
-		jwtSecret:             jwtSecret,
-		client:                client,
-		profilePicturesBucket: profilePicturesBucket,
-		staticServiceEnpoint:  staticServiceEnpoint,
+		tracer:                  tracer,
+		repository:              repository,
+		jwtSecret:               jwtSecret,
+		client:                  client,
+		profilePicturesBucket:   profilePicturesBucket,
+		staticServiceEnpoint:    staticServiceEnpoint,
+		assignmentServiceClient: assignmentServiceClient,
 	}
 }
 
@@ -142,6 +147,53 @@ func (s *Service) Me(ctx context.Context, userID string) (user, errors.ServiceEr
 	return user, nil
 }
 
+func (s *Service) GetUserByEmail(ctx context.Context, email string) (user, errors.ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetUserByEmail")
+	defer span.End()
+
+	user, err := s.repository.FindUserByEmail(ctx, email)
+	if err == sql.ErrNoRows {
+		return user, s.newErrorWithCLientMessage(code.Code_NOT_FOUND, err, "user not found")
+	}
+	if err != nil {
+		return user, s.newInternalError(err)
+	}
+
+	return user, nil
+}
+
+func (s *Service) GetUserByID(ctx context.Context, userID string) (user, errors.ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetUserByID")
+	defer span.End()
+
+	user, err := s.repository.FindUserByID(ctx, userID)
+	if err == sql.ErrNoRows {
+		return user, s.newErrorWithCLientMessage(code.Code_NOT_FOUND, err, "user not found")
+	}
+	if err != nil {
+		return user, s.newInternalError(err)
+	}
+
+	return user, nil
+}
+
+func (s *Service) UpdatePreferences(ctx context.Context, userID string, req UpdatePreferencesRequest) (user, errors.ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.UpdatePreferences")
+	defer span.End()
+
+	user, err := s.repository.UpdateUserPreferences(ctx, userID, req.Timezone, req.Locale)
+	if err == sql.ErrNoRows {
+		return user, s.newError(code.Code_PERMISSION_DENIED, err)
+	}
+	if err != nil {
+		return user, s.newInternalError(err)
+	}
+
+	user.addPrefixToProfilePictureURL(s.staticServiceEnpoint)
+
+	return user, nil
+}
+
 func (s *Service) ChangeProfilePicture(ctx context.Context, userID string, file []byte) (user, errors.ServiceError) {
 	ctx, span := s.tracer.Start(ctx, "Service.ChangeProfilePicture")
 	defer span.End()
@@ -247,5 +299,30 @@ func (s *Service) AddCoin(ctx context.Context, userID string, coin int32) (user,
 		return user, s.newInternalError(err)
 	}
 
+	s.publishCoinsUpdated(ctx, user, coin)
+
 	return user, nil
 }
+
+func (s *Service) publishCoinsUpdated(ctx context.Context, user user, delta int32) {
+	ctx, span := s.tracer.Start(ctx, "Service.publishCoinsUpdated")
+	defer span.End()
+
+	payload, err := json.Marshal(coinsUpdatedPayload{
+		Coin:  user.Coin,
+		Delta: delta,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return
+	}
+
+	_, err = s.assignmentServiceClient.PublishUserEvent(ctx, &assignmentservice.PublishUserEventRequest{
+		UserId:  user.ID,
+		Event:   coinsUpdatedEvent,
+		Payload: payload,
+	})
+	if err != nil {
+		span.RecordError(err)
+	}
+}
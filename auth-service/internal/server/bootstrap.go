@@ -3,6 +3,7 @@ package server
 import (
 	"github.com/MasLazu/dev-ops-porto/auth-service/internal/app"
 	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/assignmentservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/genproto/authservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/middleware"
 	"github.com/MasLazu/dev-ops-porto/pkg/monitoring"
@@ -13,7 +14,7 @@ import (
 	"go.opentelemetry.io/otel"
 )
 
-func bootstrap(config config, db *database.Service, logger *monitoring.Logger) (*server.HttpServer, *server.GrpcServer) {
+func bootstrap(config config, db *database.Service, logger *monitoring.Logger, assignmentServiceClient assignmentservice.AssignmentServiceClient) (*server.HttpServer, *server.GrpcServer) {
 	client := s3.NewFromConfig(config.aws.awsConfig, func(o *s3.Options) {
 		o.UsePathStyle = true
 		o.BaseEndpoint = aws.String(config.aws.s3.enpoint)
@@ -26,7 +27,7 @@ func bootstrap(config config, db *database.Service, logger *monitoring.Logger) (
 	handlerTracer := util.NewHandlerTracer(tracer)
 	authMiddleware := middleware.NewAuthMiddleware(config.jwtSecret, responseWriter, handlerTracer)
 	repository := app.NewRepository(db, tracer)
-	service := app.NewService(tracer, repository, config.jwtSecret, client, config.aws.s3.bucketNames.profilePictures, config.staticServiceEnpoint)
+	service := app.NewService(tracer, repository, config.jwtSecret, client, config.aws.s3.bucketNames.profilePictures, config.staticServiceEnpoint, assignmentServiceClient)
 	handler := NewHttpHandler(service, authMiddleware, tracer, responseWriter, requestDecoder, validator, handlerTracer)
 	grpcHandler := NewGrpcHandler(tracer, service)
 
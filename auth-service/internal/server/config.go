@@ -27,14 +27,15 @@ type AwsConfig struct {
 }
 
 type config struct {
-	httpPort             int
-	grpcPort             int
-	serviceName          string
-	otlpDomain           string
-	jwtSecret            []byte
-	database             database.Config
-	aws                  AwsConfig
-	staticServiceEnpoint string
+	httpPort                    int
+	grpcPort                    int
+	serviceName                 string
+	otlpDomain                  string
+	jwtSecret                   []byte
+	database                    database.Config
+	aws                         AwsConfig
+	staticServiceEnpoint        string
+	grpcAssignmentServiceDomain string
 }
 
 func getConfig(ctx context.Context) (config, error) {
@@ -59,13 +60,14 @@ func getConfig(ctx context.Context) (config, error) {
 	}
 
 	return config{
-		httpPort:             httpPort,
-		grpcPort:             grpcPort,
-		otlpDomain:           os.Getenv("OTLP_DOMAIN"),
-		jwtSecret:            []byte(os.Getenv("JWT_SECRET")),
-		serviceName:          "auth-service",
-		database:             dbConfig,
-		staticServiceEnpoint: os.Getenv("PUBLIC_STATIC_SERVICE_ENDPOINT"),
+		httpPort:                    httpPort,
+		grpcPort:                    grpcPort,
+		otlpDomain:                  os.Getenv("OTLP_DOMAIN"),
+		jwtSecret:                   []byte(os.Getenv("JWT_SECRET")),
+		serviceName:                 "auth-service",
+		database:                    dbConfig,
+		staticServiceEnpoint:        os.Getenv("PUBLIC_STATIC_SERVICE_ENDPOINT"),
+		grpcAssignmentServiceDomain: os.Getenv("GRPC_ASSIGNMENT_SERVICE_DOMAIN"),
 		aws: AwsConfig{
 			awsConfig: awsConfig,
 			s3: s3Config{
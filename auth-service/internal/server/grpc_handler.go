@@ -54,3 +54,63 @@ func (h *GrpcHandler) ReduceUserCoins(ctx context.Context, req *authservice.User
 
 	return &authservice.EmptyResponse{}, nil
 }
+
+func (h *GrpcHandler) GetUserByEmail(ctx context.Context, req *authservice.GetUserByEmailRequest) (*authservice.UserResponse, error) {
+	_, span := h.tracer.Start(ctx, "GrpcHandler.GetUserByEmail")
+	defer span.End()
+
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	user, err := h.service.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, status.Error(err.GrpcCode(), err.ClientMessage())
+	}
+
+	return &authservice.UserResponse{
+		Id:    user.ID,
+		Email: user.Email,
+		Name:  user.Name,
+	}, nil
+}
+
+func (h *GrpcHandler) GetUserByID(ctx context.Context, req *authservice.GetUserByIDRequest) (*authservice.UserResponse, error) {
+	_, span := h.tracer.Start(ctx, "GrpcHandler.GetUserByID")
+	defer span.End()
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	user, err := h.service.GetUserByID(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(err.GrpcCode(), err.ClientMessage())
+	}
+
+	return &authservice.UserResponse{
+		Id:    user.ID,
+		Email: user.Email,
+		Name:  user.Name,
+	}, nil
+}
+
+func (h *GrpcHandler) GetUserPreferences(ctx context.Context, req *authservice.GetUserByIDRequest) (*authservice.UserPreferencesResponse, error) {
+	_, span := h.tracer.Start(ctx, "GrpcHandler.GetUserPreferences")
+	defer span.End()
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	user, err := h.service.GetUserByID(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(err.GrpcCode(), err.ClientMessage())
+	}
+
+	return &authservice.UserPreferencesResponse{
+		UserId:   user.ID,
+		Timezone: user.Timezone,
+		Locale:   user.Locale,
+	}, nil
+}
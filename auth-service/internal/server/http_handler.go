@@ -49,6 +49,7 @@ func (h *HttpHandler) setupRoutes(c *chi.Mux) http.Handler {
 	c.Group(func(c chi.Router) {
 		c.Use(h.authMiddleware.Auth)
 		c.Get("/me", h.Me)
+		c.Put("/me/preferences", h.UpdatePreferences)
 		c.Post("/me/profile-picture", h.ChangeProfilePicture)
 		c.Delete("/me/profile-picture", h.DeleteProfilePicture)
 	})
@@ -132,6 +133,36 @@ func (h *HttpHandler) Me(w http.ResponseWriter, r *http.Request) {
 	h.responseWriter.WriteSuccessResponse(ctx, w, user)
 }
 
+func (h *HttpHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.UpdatePreferences")
+	defer span.End()
+
+	userID, err := util.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+		return
+	}
+
+	var req app.UpdatePreferencesRequest
+	if err := h.requestDecoder.Decode(ctx, r, &req); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if err := h.validator.Validate(ctx, req); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	user, serviceErr := h.service.UpdatePreferences(ctx, userID, req)
+	if serviceErr != nil {
+		h.responseWriter.WriteErrorResponse(ctx, w, serviceErr.HttpCode(), serviceErr.ClientMessage())
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, user)
+}
+
 func (h *HttpHandler) ChangeProfilePicture(w http.ResponseWriter, r *http.Request) {
 	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.ChangeProfilePicture")
 	defer span.End()
@@ -9,7 +9,9 @@ import (
 	"sync"
 
 	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/assignmentservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/monitoring"
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
 	"go.opentelemetry.io/otel/log"
 )
 
@@ -46,7 +48,18 @@ func Run(ctx context.Context) error {
 	}()
 	logger.Info(ctx, "Connected to database", log.String("host", config.database.Host), log.Int("port", config.database.Port))
 
-	httpServer, grpcServer := bootstrap(config, db, logger)
+	assignmentServiceConn, err := util.NewGRPCClient(ctx, config.grpcAssignmentServiceDomain, logger)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("Failed to connect to gRPC server: %v", err), log.String("address", config.grpcAssignmentServiceDomain))
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, assignmentServiceConn.Close())
+	}()
+
+	assignmentServiceClient := assignmentservice.NewAssignmentServiceClient(assignmentServiceConn)
+
+	httpServer, grpcServer := bootstrap(config, db, logger, assignmentServiceClient)
 
 	var wg sync.WaitGroup
 	wg.Add(2)
@@ -0,0 +1,3 @@
+ALTER TABLE users DROP COLUMN IF EXISTS locale;
+
+ALTER TABLE users DROP COLUMN IF EXISTS timezone;
\ No newline at end of file
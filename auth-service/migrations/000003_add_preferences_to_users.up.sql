@@ -0,0 +1,3 @@
+ALTER TABLE users ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+ALTER TABLE users ADD COLUMN locale VARCHAR(35) NOT NULL DEFAULT 'en';
\ No newline at end of file
@@ -0,0 +1,304 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	err "github.com/MasLazu/dev-ops-porto/pkg/errors"
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"google.golang.org/genproto/googleapis/rpc/code"
+)
+
+func (s *Service) GetEvents(ctx context.Context) ([]Event, err.ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetEvents")
+	defer span.End()
+
+	events, err := s.eventRepository.GetEvents(ctx)
+	if err != nil {
+		return events, s.newInternalError(err)
+	}
+
+	return events, nil
+}
+
+func (s *Service) CreateEvent(ctx context.Context, req CreateEventRequest) (Event, err.ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.CreateEvent")
+	defer span.End()
+
+	name, ok := missionservice.TriggerMissionEvent_name[int32(req.ID)]
+	if !ok {
+		return Event{}, s.newErrorWithCLientMessage(code.Code_INVALID_ARGUMENT, nil, "Event is not defined by the mission service")
+	}
+
+	event, err := s.eventRepository.InsertEvent(ctx, Event{
+		ID:          req.ID,
+		Name:        name,
+		Description: req.Description,
+		Enabled:     req.Enabled == nil || *req.Enabled,
+	})
+	if err == sql.ErrNoRows {
+		return event, s.newErrorWithCLientMessage(code.Code_ALREADY_EXISTS, err, "Event already exists")
+	}
+	if err != nil {
+		return event, s.newInternalError(err)
+	}
+
+	return event, nil
+}
+
+func (s *Service) UpdateEvent(ctx context.Context, id int, req UpdateEventRequest) (Event, err.ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.UpdateEvent")
+	defer span.End()
+
+	event, err := s.eventRepository.GetEventByID(ctx, id)
+	if err == sql.ErrNoRows {
+		return event, s.newErrorWithCLientMessage(code.Code_NOT_FOUND, err, "Event not found")
+	}
+	if err != nil {
+		return event, s.newInternalError(err)
+	}
+
+	event.Description = req.Description
+	if req.Enabled != nil {
+		event.Enabled = *req.Enabled
+	}
+
+	event, err = s.eventRepository.UpdateEvent(ctx, event)
+	if err != nil {
+		return event, s.newInternalError(err)
+	}
+
+	return event, nil
+}
+
+func (s *Service) DeleteEvent(ctx context.Context, id int) err.ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.DeleteEvent")
+	defer span.End()
+
+	_, err := s.eventRepository.GetEventByID(ctx, id)
+	if err == sql.ErrNoRows {
+		return s.newErrorWithCLientMessage(code.Code_NOT_FOUND, err, "Event not found")
+	}
+	if err != nil {
+		return s.newInternalError(err)
+	}
+
+	referenced, err := s.eventRepository.IsEventReferenced(ctx, id)
+	if err != nil {
+		return s.newInternalError(err)
+	}
+	if referenced {
+		return s.newErrorWithCLientMessage(code.Code_ABORTED, nil, "Event is still in use, disable it instead")
+	}
+
+	if err := s.eventRepository.DeleteEventByID(ctx, id); err != nil {
+		return s.newInternalError(err)
+	}
+
+	return nil
+}
+
+func (s *Service) CheckEventCatalog(ctx context.Context) error {
+	ctx, span := s.tracer.Start(ctx, "Service.CheckEventCatalog")
+	defer span.End()
+
+	events, err := s.eventRepository.GetEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	return compareEventCatalog(events)
+}
+
+func (s *Service) GetMissions(ctx context.Context) ([]AdminMission, err.ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetMissions")
+	defer span.End()
+
+	missions, err := s.missionRepository.GetMissions(ctx)
+	if err != nil {
+		return nil, s.newInternalError(err)
+	}
+
+	result := make([]AdminMission, len(missions))
+	for i, mission := range missions {
+		result[i] = newAdminMission(mission)
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetMission(ctx context.Context, id int) (AdminMission, err.ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.GetMission")
+	defer span.End()
+
+	mission, serviceErr := s.getMissionByID(ctx, id)
+	if serviceErr != nil {
+		return AdminMission{}, serviceErr
+	}
+
+	return newAdminMission(mission), nil
+}
+
+func (s *Service) CreateMission(ctx context.Context, req MissionRequest) (AdminMission, err.ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.CreateMission")
+	defer span.End()
+
+	if serviceErr := s.validateMissionRules(ctx, req.Rules); serviceErr != nil {
+		return AdminMission{}, serviceErr
+	}
+
+	mission, err := s.missionRepository.InsertMission(ctx, req.applyTo(Mission{Enabled: true}))
+	if err != nil {
+		return AdminMission{}, s.newInternalError(err)
+	}
+
+	return newAdminMission(mission), nil
+}
+
+func (s *Service) UpdateMission(ctx context.Context, id int, req MissionRequest) (AdminMission, err.ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.UpdateMission")
+	defer span.End()
+
+	mission, serviceErr := s.getMissionByID(ctx, id)
+	if serviceErr != nil {
+		return AdminMission{}, serviceErr
+	}
+
+	if serviceErr := s.validateMissionRules(ctx, req.Rules); serviceErr != nil {
+		return AdminMission{}, serviceErr
+	}
+
+	updated, err := s.missionRepository.UpdateMission(ctx, req.applyTo(mission))
+	if err != nil {
+		return AdminMission{}, s.newInternalError(err)
+	}
+
+	if updated.ImageKey == nil {
+		s.deleteMissionImage(ctx, mission.ImageKey)
+	}
+
+	return newAdminMission(updated), nil
+}
+
+func (s *Service) DeleteMission(ctx context.Context, id int) err.ServiceError {
+	ctx, span := s.tracer.Start(ctx, "Service.DeleteMission")
+	defer span.End()
+
+	mission, serviceErr := s.getMissionByID(ctx, id)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	assigned, err := s.missionRepository.IsMissionAssigned(ctx, id)
+	if err != nil {
+		return s.newInternalError(err)
+	}
+	if assigned {
+		return s.newErrorWithCLientMessage(code.Code_ABORTED, nil, "Mission is assigned to users, disable it instead")
+	}
+
+	if err := s.missionRepository.DeleteMissionByID(ctx, id); err != nil {
+		return s.newInternalError(err)
+	}
+
+	s.deleteMissionImage(ctx, mission.ImageKey)
+
+	return nil
+}
+
+func (s *Service) UploadMissionImage(ctx context.Context, id int, file []byte) (AdminMission, err.ServiceError) {
+	ctx, span := s.tracer.Start(ctx, "Service.UploadMissionImage")
+	defer span.End()
+
+	mission, serviceErr := s.getMissionByID(ctx, id)
+	if serviceErr != nil {
+		return AdminMission{}, serviceErr
+	}
+
+	mimeType := http.DetectContentType(file)
+	if !strings.HasPrefix(mimeType, "image/") {
+		return AdminMission{}, s.newErrorWithCLientMessage(code.Code_INVALID_ARGUMENT, nil, "File must be an image")
+	}
+
+	key := fmt.Sprintf("%d/%d", mission.ID, time.Now().UnixNano())
+	_, err := s.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      &s.missionImagesBucket,
+		Key:         &key,
+		Body:        bytes.NewReader(file),
+		ContentType: &mimeType,
+	})
+	if err != nil {
+		return AdminMission{}, s.newInternalError(err)
+	}
+
+	previousKey := mission.ImageKey
+	mission.ImageKey = &key
+	mission.ImagePath = s.staticServiceEnpoint + missionImageStaticURLPrefix + key
+
+	updated, err := s.missionRepository.UpdateMission(ctx, mission)
+	if err != nil {
+		s.deleteMissionImage(ctx, &key)
+		return AdminMission{}, s.newInternalError(err)
+	}
+
+	s.deleteMissionImage(ctx, previousKey)
+
+	return newAdminMission(updated), nil
+}
+
+func (s *Service) getMissionByID(ctx context.Context, id int) (Mission, err.ServiceError) {
+	mission, err := s.missionRepository.GetMissionByID(ctx, id)
+	if err == sql.ErrNoRows {
+		return mission, s.newErrorWithCLientMessage(code.Code_NOT_FOUND, err, "Mission not found")
+	}
+	if err != nil {
+		return mission, s.newInternalError(err)
+	}
+
+	return mission, nil
+}
+
+func (s *Service) validateMissionRules(ctx context.Context, rules MissionRules) err.ServiceError {
+	events, err := s.eventRepository.GetEvents(ctx)
+	if err != nil {
+		return s.newInternalError(err)
+	}
+
+	known := make(map[int]bool, len(events))
+	for _, event := range events {
+		known[event.ID] = true
+	}
+
+	for _, eventID := range rules.eventIDs() {
+		if !known[eventID] {
+			return s.newErrorWithCLientMessage(code.Code_INVALID_ARGUMENT, nil, fmt.Sprintf("Event %d does not exist", eventID))
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) deleteMissionImage(ctx context.Context, key *string) {
+	if key == nil {
+		return
+	}
+
+	_, span := s.tracer.Start(ctx, "Service.deleteMissionImage")
+	defer span.End()
+
+	_, err := s.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: &s.missionImagesBucket,
+		Key:    key,
+	})
+	if err != nil {
+		span.RecordError(err)
+		log.Printf("Error: failed to delete mission image %s: %v", *key, err)
+	}
+}
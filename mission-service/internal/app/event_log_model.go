@@ -0,0 +1,186 @@
+package app
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+)
+
+const eventLogRetention = (missionMaxWindowDays + 1) * 24 * time.Hour
+
+type EventAttributes map[string]any
+
+func (a *EventAttributes) Scan(value any) error {
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	case nil:
+		*a = EventAttributes{}
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into EventAttributes", value)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(a)
+}
+
+func (a EventAttributes) Value() (driver.Value, error) {
+	if a == nil {
+		return "{}", nil
+	}
+
+	data, err := json.Marshal(a)
+	return string(data), err
+}
+
+const (
+	eventAttributeIsImportant = "is_important"
+	eventAttributeDueDate     = "due_date"
+	eventAttributeCompletion  = "completion"
+
+	eventCompletionEarly = "early"
+	eventCompletionLate  = "late"
+)
+
+var eventCancellations = map[int][]int{
+	int(missionservice.TriggerMissionEvent_MISSION_EVENT_DELETE_ASSIGNMENT): {
+		int(missionservice.TriggerMissionEvent_MISSION_EVENT_CREATE_ASSIGNMENT),
+	},
+	int(missionservice.TriggerMissionEvent_MISSION_EVENT_UNDONE_ASSIGNMENT): {
+		int(missionservice.TriggerMissionEvent_MISSION_EVENT_DONE_ASSIGNMENT),
+	},
+}
+
+type EventLog struct {
+	ID         int64           `json:"id"`
+	UserID     string          `json:"user_id"`
+	EventID    int             `json:"event_id"`
+	Amount     int             `json:"amount"`
+	Attributes EventAttributes `json:"attributes"`
+	ExternalID *string         `json:"external_id"`
+	SourceID   *string         `json:"source_id"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+func newEventLog(userID string, event *missionservice.MissionEvent, now time.Time) EventLog {
+	log := EventLog{
+		UserID:     userID,
+		EventID:    int(event.Event),
+		Amount:     max(int(event.Amount), 1),
+		Attributes: newEventAttributes(event.Attributes),
+		OccurredAt: now,
+	}
+
+	if event.EventId != "" {
+		log.ExternalID = &event.EventId
+	}
+	if event.SourceId != "" {
+		log.SourceID = &event.SourceId
+	}
+	if occurredAt := event.OccurredAt.AsTime(); event.OccurredAt.IsValid() && occurredAt.Before(now) {
+		log.OccurredAt = occurredAt
+	}
+
+	return log
+}
+
+func newEventAttributes(attributes *missionservice.MissionEventAttributes) EventAttributes {
+	result := EventAttributes{}
+	if attributes == nil {
+		return result
+	}
+
+	result[eventAttributeIsImportant] = attributes.IsImportant
+	if attributes.DueDate.IsValid() {
+		result[eventAttributeDueDate] = attributes.DueDate.AsTime().UTC().Format(time.RFC3339)
+	}
+
+	switch attributes.Completion {
+	case missionservice.MissionEventCompletion_MISSION_EVENT_COMPLETION_EARLY:
+		result[eventAttributeCompletion] = eventCompletionEarly
+	case missionservice.MissionEventCompletion_MISSION_EVENT_COMPLETION_LATE:
+		result[eventAttributeCompletion] = eventCompletionLate
+	}
+
+	return result
+}
+
+func cancellingEventIDs(eventIDs []int) []int {
+	ids := make([]int, 0)
+	for canceller, cancelled := range eventCancellations {
+		for _, eventID := range cancelled {
+			if slices.Contains(eventIDs, eventID) && !slices.Contains(ids, canceller) {
+				ids = append(ids, canceller)
+			}
+		}
+	}
+
+	return ids
+}
+
+type eventSource struct {
+	eventID  int
+	sourceID string
+}
+
+func cancelEventLogs(logs []EventLog) []EventLog {
+	cancelled := make([]bool, len(logs))
+	open := make(map[eventSource][]int)
+
+	for i, log := range logs {
+		if log.SourceID == nil {
+			continue
+		}
+
+		if targets, ok := eventCancellations[log.EventID]; ok {
+			for _, target := range targets {
+				key := eventSource{target, *log.SourceID}
+				if pending := open[key]; len(pending) > 0 {
+					cancelled[pending[len(pending)-1]] = true
+					cancelled[i] = true
+					open[key] = pending[:len(pending)-1]
+					break
+				}
+			}
+		}
+
+		if !cancelled[i] {
+			key := eventSource{log.EventID, *log.SourceID}
+			open[key] = append(open[key], i)
+		}
+	}
+
+	effective := make([]EventLog, 0, len(logs))
+	for i, log := range logs {
+		if !cancelled[i] {
+			effective = append(effective, log)
+		}
+	}
+
+	return effective
+}
+
+func (l EventLog) lookup(attribute string) (any, bool) {
+	if attribute == missionRuleDefaultField {
+		return l.Amount, true
+	}
+
+	value, ok := l.Attributes[attribute]
+	return value, ok && value != nil
+}
+
+func (l EventLog) value(attribute string) any {
+	value, _ := l.lookup(attribute)
+	return value
+}
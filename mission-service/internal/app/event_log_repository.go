@@ -0,0 +1,117 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type EventLogRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewEventLogRepository(db *database.Service, tracer trace.Tracer) *EventLogRepository {
+	return &EventLogRepository{db, tracer}
+}
+
+func (r *EventLogRepository) InsertEventLogs(ctx context.Context, logs []EventLog) error {
+	ctx, span := r.tracer.Start(ctx, "EventLogRepository.InsertEventLogs")
+	defer span.End()
+
+	if len(logs) == 0 {
+		return nil
+	}
+
+	var values []string
+	args := make([]interface{}, 0, len(logs)*7)
+	for i, log := range logs {
+		values = append(values, fmt.Sprintf(
+			"($%d, $%d, $%d, $%d::jsonb, $%d, $%d, $%d)",
+			i*7+1, i*7+2, i*7+3, i*7+4, i*7+5, i*7+6, i*7+7,
+		))
+		args = append(args, log.UserID, log.EventID, log.Amount, log.Attributes, log.ExternalID, log.SourceID, log.OccurredAt)
+	}
+
+	query := fmt.Sprintf(`
+	INSERT INTO event_logs (user_id, event_id, amount, attributes, external_id, source_id, occurred_at)
+	VALUES %s
+	ON CONFLICT (user_id, external_id) DO NOTHING`, strings.Join(values, ", "))
+
+	_, err := r.db.Pool.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+func (r *EventLogRepository) GetEventLogsByUserIDSince(
+	ctx context.Context,
+	userID string,
+	since time.Time,
+	eventIDs []int,
+) ([]EventLog, error) {
+	ctx, span := r.tracer.Start(ctx, "EventLogRepository.GetEventLogsByUserIDSince")
+	defer span.End()
+
+	logs := []EventLog{}
+
+	if len(eventIDs) == 0 {
+		return logs, nil
+	}
+
+	placeholders := make([]string, len(eventIDs))
+	args := make([]interface{}, 0, len(eventIDs)+2)
+	args = append(args, userID, since)
+	for i, eventID := range eventIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+3)
+		args = append(args, eventID)
+	}
+
+	query := fmt.Sprintf(`
+	SELECT id, user_id, event_id, amount, attributes, external_id, source_id, occurred_at
+	FROM event_logs
+	WHERE user_id = $1 AND occurred_at >= $2 AND event_id IN (%s)
+	ORDER BY occurred_at, id
+	`, strings.Join(placeholders, ", "))
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return logs, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var l EventLog
+		err := rows.Scan(&l.ID, &l.UserID, &l.EventID, &l.Amount, &l.Attributes, &l.ExternalID, &l.SourceID, &l.OccurredAt)
+		if err != nil {
+			return logs, err
+		}
+
+		logs = append(logs, l)
+	}
+
+	return logs, rows.Err()
+}
+
+func (r *EventLogRepository) DeleteEventLogsByUserIDBeforeWithTransaction(
+	ctx context.Context,
+	tx *sql.Tx,
+	userID string,
+	before time.Time,
+) error {
+	ctx, span := r.tracer.Start(ctx, "EventLogRepository.DeleteEventLogsByUserIDBeforeWithTransaction")
+	defer span.End()
+
+	query := `
+	DELETE FROM event_logs
+	WHERE user_id = $1 AND occurred_at < $2
+	`
+
+	_, err := tx.ExecContext(ctx, query, userID, before)
+
+	return err
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+)
+
+type Event struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type CreateEventRequest struct {
+	ID          int    `json:"id" validate:"required,min=1"`
+	Description string `json:"description" validate:"max=255"`
+	Enabled     *bool  `json:"enabled"`
+}
+
+type UpdateEventRequest struct {
+	Description string `json:"description" validate:"max=255"`
+	Enabled     *bool  `json:"enabled"`
+}
+
+// compareEventCatalog reports every event whose id or name disagrees between
+// the database catalog and the TriggerMissionEvent proto enum.
+func compareEventCatalog(events []Event) error {
+	mismatches := make([]error, 0)
+	stored := make(map[int32]bool, len(events))
+	for _, event := range events {
+		stored[int32(event.ID)] = true
+
+		name, ok := missionservice.TriggerMissionEvent_name[int32(event.ID)]
+		switch {
+		case !ok || event.ID == 0:
+			mismatches = append(mismatches, fmt.Errorf("event %d (%s) is not defined by the mission service", event.ID, event.Name))
+		case name != event.Name:
+			mismatches = append(mismatches, fmt.Errorf("event %d is %s in the database but %s in the mission service", event.ID, event.Name, name))
+		}
+	}
+
+	ids := make([]int32, 0, len(missionservice.TriggerMissionEvent_name))
+	for id := range missionservice.TriggerMissionEvent_name {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		if id != 0 && !stored[id] {
+			mismatches = append(mismatches, fmt.Errorf("event %d (%s) is missing from the database", id, missionservice.TriggerMissionEvent_name[id]))
+		}
+	}
+
+	return errors.Join(mismatches...)
+}
@@ -0,0 +1,119 @@
+package app
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+)
+
+func newTestEventCatalog() []Event {
+	events := make([]Event, 0, len(missionservice.TriggerMissionEvent_name))
+	for id, name := range missionservice.TriggerMissionEvent_name {
+		if id != 0 {
+			events = append(events, Event{ID: int(id), Name: name})
+		}
+	}
+	slices.SortFunc(events, func(a, b Event) int { return a.ID - b.ID })
+
+	return events
+}
+
+func TestCompareEventCatalog(t *testing.T) {
+	undone := int(missionservice.TriggerMissionEvent_MISSION_EVENT_UNDONE_ASSIGNMENT)
+	done := int(missionservice.TriggerMissionEvent_MISSION_EVENT_DONE_ASSIGNMENT)
+
+	tests := []struct {
+		name   string
+		events func([]Event) []Event
+		want   []string
+	}{
+		{
+			name:   "matching catalog",
+			events: func(events []Event) []Event { return events },
+		},
+		{
+			name: "missing event",
+			events: func(events []Event) []Event {
+				return slices.DeleteFunc(events, func(event Event) bool { return event.ID == undone })
+			},
+			want: []string{"event 3 (MISSION_EVENT_UNDONE_ASSIGNMENT) is missing from the database"},
+		},
+		{
+			name: "renamed event",
+			events: func(events []Event) []Event {
+				for i := range events {
+					if events[i].ID == done {
+						events[i].Name = "MISSION_EVENT_COMPLETE_ASSIGNMENT"
+					}
+				}
+				return events
+			},
+			want: []string{"event 2 is MISSION_EVENT_COMPLETE_ASSIGNMENT in the database but MISSION_EVENT_DONE_ASSIGNMENT in the mission service"},
+		},
+		{
+			name: "event unknown to the proto enum",
+			events: func(events []Event) []Event {
+				return append(events, Event{ID: 99, Name: "MISSION_EVENT_LEGACY"})
+			},
+			want: []string{"event 99 (MISSION_EVENT_LEGACY) is not defined by the mission service"},
+		},
+		{
+			name: "unknown placeholder stored",
+			events: func(events []Event) []Event {
+				return append(events, Event{ID: 0, Name: "MISSION_EVENT_UNKNOWN"})
+			},
+			want: []string{"event 0 (MISSION_EVENT_UNKNOWN) is not defined by the mission service"},
+		},
+		{
+			name: "seeded ids shifted by one",
+			events: func(events []Event) []Event {
+				shifted := make([]Event, 0, len(events))
+				for _, event := range events {
+					if event.ID > 1 {
+						shifted = append(shifted, Event{ID: event.ID - 1, Name: event.Name})
+					}
+				}
+				return shifted
+			},
+			want: []string{
+				"event 1 is MISSION_EVENT_DONE_ASSIGNMENT in the database but MISSION_EVENT_CREATE_ASSIGNMENT in the mission service",
+				"event 2 is MISSION_EVENT_UNDONE_ASSIGNMENT in the database but MISSION_EVENT_DONE_ASSIGNMENT in the mission service",
+				"event 3 is MISSION_EVENT_DELETE_ASSIGNMENT in the database but MISSION_EVENT_UNDONE_ASSIGNMENT in the mission service",
+				"event 4 is MISSION_EVENT_FOCUS_SESSION_COMPLETED in the database but MISSION_EVENT_DELETE_ASSIGNMENT in the mission service",
+				"event 5 (MISSION_EVENT_FOCUS_SESSION_COMPLETED) is missing from the database",
+			},
+		},
+		{
+			name:   "empty catalog",
+			events: func([]Event) []Event { return nil },
+			want: []string{
+				"event 1 (MISSION_EVENT_CREATE_ASSIGNMENT) is missing from the database",
+				"event 2 (MISSION_EVENT_DONE_ASSIGNMENT) is missing from the database",
+				"event 3 (MISSION_EVENT_UNDONE_ASSIGNMENT) is missing from the database",
+				"event 4 (MISSION_EVENT_DELETE_ASSIGNMENT) is missing from the database",
+				"event 5 (MISSION_EVENT_FOCUS_SESSION_COMPLETED) is missing from the database",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := compareEventCatalog(tt.events(newTestEventCatalog()))
+
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("compareEventCatalog() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("compareEventCatalog() = nil, want %q", tt.want)
+			}
+			if got := strings.Split(err.Error(), "\n"); !slices.Equal(got, tt.want) {
+				t.Errorf("compareEventCatalog() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
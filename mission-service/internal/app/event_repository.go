@@ -0,0 +1,175 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type EventRepository struct {
+	db     *database.Service
+	tracer trace.Tracer
+}
+
+func NewEventRepository(db *database.Service, tracer trace.Tracer) *EventRepository {
+	return &EventRepository{db, tracer}
+}
+
+func (r *EventRepository) GetEvents(ctx context.Context) ([]Event, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.GetEvents")
+	defer span.End()
+
+	events := []Event{}
+
+	query := `
+	SELECT id, name, description, enabled, created_at, updated_at
+	FROM events
+	ORDER BY id
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query)
+	if err != nil {
+		return events, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e Event
+		err := rows.Scan(&e.ID, &e.Name, &e.Description, &e.Enabled, &e.CreatedAt, &e.UpdatedAt)
+		if err != nil {
+			return events, err
+		}
+
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+func (r *EventRepository) GetEventByID(ctx context.Context, id int) (Event, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.GetEventByID")
+	defer span.End()
+
+	query := `
+	SELECT id, name, description, enabled, created_at, updated_at
+	FROM events
+	WHERE id = $1
+	`
+
+	var e Event
+	err := r.db.Pool.QueryRowContext(ctx, query, id).
+		Scan(&e.ID, &e.Name, &e.Description, &e.Enabled, &e.CreatedAt, &e.UpdatedAt)
+
+	return e, err
+}
+
+func (r *EventRepository) GetEventsByIDs(ctx context.Context, ids []int) ([]Event, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.GetEventsByIDs")
+	defer span.End()
+
+	events := []Event{}
+
+	if len(ids) == 0 {
+		return events, nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+
+	query := fmt.Sprintf(`
+	SELECT id, name, description, enabled, created_at, updated_at
+	FROM events
+	WHERE id IN (%s)
+	ORDER BY id
+	`, strings.Join(placeholders, ", "))
+
+	rows, err := r.db.Pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return events, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e Event
+		err := rows.Scan(&e.ID, &e.Name, &e.Description, &e.Enabled, &e.CreatedAt, &e.UpdatedAt)
+		if err != nil {
+			return events, err
+		}
+
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+func (r *EventRepository) InsertEvent(ctx context.Context, event Event) (Event, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.InsertEvent")
+	defer span.End()
+
+	query := `
+	INSERT INTO events (id, name, description, enabled)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (id) DO NOTHING
+	RETURNING id, name, description, enabled, created_at, updated_at
+	`
+
+	var e Event
+	err := r.db.Pool.QueryRowContext(ctx, query, event.ID, event.Name, event.Description, event.Enabled).
+		Scan(&e.ID, &e.Name, &e.Description, &e.Enabled, &e.CreatedAt, &e.UpdatedAt)
+
+	return e, err
+}
+
+func (r *EventRepository) UpdateEvent(ctx context.Context, event Event) (Event, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.UpdateEvent")
+	defer span.End()
+
+	query := `
+	UPDATE events
+	SET description = $1, enabled = $2, updated_at = CURRENT_TIMESTAMP
+	WHERE id = $3
+	RETURNING id, name, description, enabled, created_at, updated_at
+	`
+
+	var e Event
+	err := r.db.Pool.QueryRowContext(ctx, query, event.Description, event.Enabled, event.ID).
+		Scan(&e.ID, &e.Name, &e.Description, &e.Enabled, &e.CreatedAt, &e.UpdatedAt)
+
+	return e, err
+}
+
+func (r *EventRepository) IsEventReferenced(ctx context.Context, id int) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.IsEventReferenced")
+	defer span.End()
+
+	query := `
+	SELECT EXISTS (SELECT 1 FROM event_logs WHERE event_id = $1)
+	OR EXISTS (SELECT 1 FROM missions WHERE rules @> $2::jsonb)
+	`
+
+	var referenced bool
+	err := r.db.Pool.QueryRowContext(ctx, query, id, fmt.Sprintf(`[{"event": %d}]`, id)).Scan(&referenced)
+
+	return referenced, err
+}
+
+func (r *EventRepository) DeleteEventByID(ctx context.Context, id int) error {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.DeleteEventByID")
+	defer span.End()
+
+	query := `
+	DELETE FROM events
+	WHERE id = $1
+	`
+
+	_, err := r.db.Pool.ExecContext(ctx, query, id)
+
+	return err
+}
@@ -1,13 +1,55 @@
 package app
 
+const missionImageStaticURLPrefix = "missions/"
+
 type Mission struct {
-	ID               int    `json:"id"`
-	Title            string `json:"title"`
-	ImagePath        string `json:"image_path"`
-	Goal             int    `json:"goal"`
-	Reward           int    `json:"reward"`
-	EventEncreasorID int    `json:"-"`
-	EventDecreasorID int    `json:"-"`
-	CreatedAt        string `json:"created_at"`
-	UpdatedAt        string `json:"updated_at"`
+	ID        int          `json:"id"`
+	Title     string       `json:"title"`
+	ImagePath string       `json:"image_path"`
+	Goal      int          `json:"goal"`
+	Reward    int          `json:"reward"`
+	Rules     MissionRules `json:"-"`
+	Enabled   bool         `json:"-"`
+	ImageKey  *string      `json:"-"`
+	CreatedAt string       `json:"created_at"`
+	UpdatedAt string       `json:"updated_at"`
+}
+
+type AdminMission struct {
+	Mission
+	Rules   MissionRules `json:"rules"`
+	Enabled bool         `json:"enabled"`
+}
+
+func newAdminMission(mission Mission) AdminMission {
+	return AdminMission{
+		Mission: mission,
+		Rules:   mission.Rules,
+		Enabled: mission.Enabled,
+	}
+}
+
+type MissionRequest struct {
+	Title     string       `json:"title" validate:"required,max=255"`
+	ImagePath string       `json:"image_path" validate:"omitempty,url,max=255"`
+	Goal      int          `json:"goal" validate:"required,min=1"`
+	Reward    int          `json:"reward" validate:"min=0"`
+	Rules     MissionRules `json:"rules" validate:"required,min=1,max=10,dive"`
+	Enabled   *bool        `json:"enabled"`
+}
+
+func (r MissionRequest) applyTo(mission Mission) Mission {
+	mission.Title = r.Title
+	mission.Goal = r.Goal
+	mission.Reward = r.Reward
+	mission.Rules = r.Rules
+	if r.ImagePath != "" {
+		mission.ImagePath = r.ImagePath
+		mission.ImageKey = nil
+	}
+	if r.Enabled != nil {
+		mission.Enabled = *r.Enabled
+	}
+
+	return mission
 }
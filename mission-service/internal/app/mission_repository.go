@@ -64,6 +64,7 @@ func (r *MissionRepository) GetTwoRandomMissionIDs(ctx context.Context) ([]int,
 	query := `
 	SELECT id
 	FROM missions
+	WHERE enabled
 	ORDER BY random()
 	LIMIT 2
 	`
@@ -86,3 +87,119 @@ func (r *MissionRepository) GetTwoRandomMissionIDs(ctx context.Context) ([]int,
 
 	return ids, nil
 }
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanMission(row rowScanner) (Mission, error) {
+	var m Mission
+	var imageKey sql.NullString
+	err := row.Scan(&m.ID, &m.Title, &m.ImagePath, &m.Rules, &m.Goal, &m.Reward, &m.Enabled, &imageKey, &m.CreatedAt, &m.UpdatedAt)
+	if imageKey.Valid {
+		m.ImageKey = &imageKey.String
+	}
+
+	return m, err
+}
+
+func (r *MissionRepository) GetMissions(ctx context.Context) ([]Mission, error) {
+	ctx, span := r.tracer.Start(ctx, "MissionRepository.GetMissions")
+	defer span.End()
+
+	missions := []Mission{}
+
+	query := `
+	SELECT id, title, image_path, rules, goal, reward, enabled, image_key, created_at, updated_at
+	FROM missions
+	ORDER BY id
+	`
+
+	rows, err := r.db.Pool.QueryContext(ctx, query)
+	if err != nil {
+		return missions, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		m, err := scanMission(rows)
+		if err != nil {
+			return missions, err
+		}
+
+		missions = append(missions, m)
+	}
+
+	return missions, rows.Err()
+}
+
+func (r *MissionRepository) GetMissionByID(ctx context.Context, id int) (Mission, error) {
+	ctx, span := r.tracer.Start(ctx, "MissionRepository.GetMissionByID")
+	defer span.End()
+
+	query := `
+	SELECT id, title, image_path, rules, goal, reward, enabled, image_key, created_at, updated_at
+	FROM missions
+	WHERE id = $1
+	`
+
+	return scanMission(r.db.Pool.QueryRowContext(ctx, query, id))
+}
+
+func (r *MissionRepository) InsertMission(ctx context.Context, mission Mission) (Mission, error) {
+	ctx, span := r.tracer.Start(ctx, "MissionRepository.InsertMission")
+	defer span.End()
+
+	query := `
+	INSERT INTO missions (title, image_path, rules, goal, reward, enabled, image_key)
+	VALUES ($1, $2, $3::jsonb, $4, $5, $6, $7)
+	RETURNING id, title, image_path, rules, goal, reward, enabled, image_key, created_at, updated_at
+	`
+
+	return scanMission(r.db.Pool.QueryRowContext(ctx, query,
+		mission.Title, mission.ImagePath, mission.Rules, mission.Goal, mission.Reward, mission.Enabled, mission.ImageKey))
+}
+
+func (r *MissionRepository) UpdateMission(ctx context.Context, mission Mission) (Mission, error) {
+	ctx, span := r.tracer.Start(ctx, "MissionRepository.UpdateMission")
+	defer span.End()
+
+	query := `
+	UPDATE missions
+	SET title = $1, image_path = $2, rules = $3::jsonb, goal = $4, reward = $5, enabled = $6, image_key = $7,
+	updated_at = CURRENT_TIMESTAMP
+	WHERE id = $8
+	RETURNING id, title, image_path, rules, goal, reward, enabled, image_key, created_at, updated_at
+	`
+
+	return scanMission(r.db.Pool.QueryRowContext(ctx, query,
+		mission.Title, mission.ImagePath, mission.Rules, mission.Goal, mission.Reward, mission.Enabled, mission.ImageKey, mission.ID))
+}
+
+func (r *MissionRepository) IsMissionAssigned(ctx context.Context, id int) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "MissionRepository.IsMissionAssigned")
+	defer span.End()
+
+	query := `
+	SELECT EXISTS (SELECT 1 FROM users_missions WHERE mission_id = $1)
+	`
+
+	var assigned bool
+	err := r.db.Pool.QueryRowContext(ctx, query, id).Scan(&assigned)
+
+	return assigned, err
+}
+
+func (r *MissionRepository) DeleteMissionByID(ctx context.Context, id int) error {
+	ctx, span := r.tracer.Start(ctx, "MissionRepository.DeleteMissionByID")
+	defer span.End()
+
+	query := `
+	DELETE FROM missions
+	WHERE id = $1
+	`
+
+	_, err := r.db.Pool.ExecContext(ctx, query, id)
+
+	return err
+}
@@ -0,0 +1,264 @@
+package app
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	missionAggregationCount        = "count"
+	missionAggregationSum          = "sum"
+	missionAggregationDistinctDays = "distinct_days"
+
+	missionWindowMission = "mission"
+	missionWindowRolling = "rolling"
+
+	missionRuleDefaultField = "amount"
+	missionMaxWindowDays    = 30
+)
+
+type MissionPredicate struct {
+	Attribute string `json:"attribute" validate:"required,max=64"`
+	Operator  string `json:"operator" validate:"required,oneof=eq neq gt gte lt lte exists"`
+	Value     any    `json:"value,omitempty"`
+}
+
+type MissionWindow struct {
+	Type string `json:"type,omitempty" validate:"omitempty,oneof=mission rolling"`
+	Days int    `json:"days,omitempty" validate:"min=0,max=30"`
+}
+
+type MissionRule struct {
+	Event       int                `json:"event" validate:"required,min=1"`
+	Predicates  []MissionPredicate `json:"predicates,omitempty" validate:"max=10,dive"`
+	Aggregation string             `json:"aggregation" validate:"required,oneof=count sum distinct_days"`
+	Field       string             `json:"field,omitempty" validate:"max=64"`
+	Weight      int                `json:"weight,omitempty" validate:"min=-100,max=100"`
+	Window      MissionWindow      `json:"window,omitempty"`
+}
+
+type MissionRules []MissionRule
+
+func (r *MissionRules) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	case nil:
+		*r = MissionRules{}
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into MissionRules", value)
+	}
+}
+
+func (r MissionRules) Value() (driver.Value, error) {
+	if r == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(r)
+	return string(data), err
+}
+
+func (r MissionRules) references(eventIDs []int) bool {
+	for _, rule := range r {
+		if slices.Contains(eventIDs, rule.Event) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r MissionRules) usesDistinctDays() bool {
+	for _, rule := range r {
+		if rule.Aggregation == missionAggregationDistinctDays {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r MissionRules) eventIDs() []int {
+	ids := make([]int, 0, len(r))
+	for _, rule := range r {
+		if !slices.Contains(ids, rule.Event) {
+			ids = append(ids, rule.Event)
+		}
+	}
+
+	return ids
+}
+
+func (r MissionRules) earliestStart(missionStart, now time.Time) time.Time {
+	earliest := now
+	for _, rule := range r {
+		if start := rule.Window.start(missionStart, now); start.Before(earliest) {
+			earliest = start
+		}
+	}
+
+	return earliest
+}
+
+func (r MissionRules) progress(logs []EventLog, missionStart, now time.Time, location *time.Location, goal int) int {
+	return min(max(r.total(logs, missionStart, now, location), 0), goal)
+}
+
+func (r MissionRules) total(logs []EventLog, missionStart, now time.Time, location *time.Location) int {
+	total := 0
+	for _, rule := range r {
+		total += rule.evaluate(logs, rule.Window.start(missionStart, now), location)
+	}
+
+	return total
+}
+
+func (w MissionWindow) start(missionStart, now time.Time) time.Time {
+	if w.Type == missionWindowRolling && w.Days > 0 {
+		return now.AddDate(0, 0, -min(w.Days, missionMaxWindowDays))
+	}
+
+	return missionStart
+}
+
+func (r MissionRule) weight() int {
+	if r.Weight == 0 {
+		return 1
+	}
+
+	return r.Weight
+}
+
+func (r MissionRule) field() string {
+	if r.Field == "" {
+		return missionRuleDefaultField
+	}
+
+	return r.Field
+}
+
+func (r MissionRule) matches(log EventLog, since time.Time) bool {
+	if log.EventID != r.Event || log.OccurredAt.Before(since) {
+		return false
+	}
+
+	for _, predicate := range r.Predicates {
+		if !predicate.matches(log) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r MissionRule) evaluate(logs []EventLog, since time.Time, location *time.Location) int {
+	result := 0
+	days := make(map[string]struct{})
+
+	for _, log := range logs {
+		if !r.matches(log, since) {
+			continue
+		}
+
+		switch r.Aggregation {
+		case missionAggregationCount:
+			result++
+		case missionAggregationSum:
+			if value, ok := toNumber(log.value(r.field())); ok {
+				result += int(value)
+			}
+		case missionAggregationDistinctDays:
+			days[log.OccurredAt.In(location).Format(time.DateOnly)] = struct{}{}
+		}
+	}
+
+	if r.Aggregation == missionAggregationDistinctDays {
+		result = len(days)
+	}
+
+	return result * r.weight()
+}
+
+func (p MissionPredicate) matches(log EventLog) bool {
+	value, ok := log.lookup(p.Attribute)
+
+	if p.Operator == "exists" {
+		return ok
+	}
+	if !ok {
+		return p.Operator == "neq"
+	}
+
+	result, ok := compareValues(value, p.Value)
+	if !ok {
+		return p.Operator == "neq"
+	}
+
+	switch p.Operator {
+	case "eq":
+		return result == 0
+	case "neq":
+		return result != 0
+	case "gt":
+		return result > 0
+	case "gte":
+		return result >= 0
+	case "lt":
+		return result < 0
+	case "lte":
+		return result <= 0
+	default:
+		return false
+	}
+}
+
+func compareValues(a, b any) (int, bool) {
+	if x, ok := toNumber(a); ok {
+		if y, ok := toNumber(b); ok {
+			return cmp.Compare(x, y), true
+		}
+	}
+
+	if x, ok := a.(string); ok {
+		if y, ok := b.(string); ok {
+			return cmp.Compare(x, y), true
+		}
+	}
+
+	if x, ok := a.(bool); ok {
+		if y, ok := b.(bool); ok {
+			if x == y {
+				return 0, true
+			}
+			return 1, true
+		}
+	}
+
+	return 0, false
+}
+
+func toNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
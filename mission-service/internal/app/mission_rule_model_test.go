@@ -0,0 +1,356 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func newTestEventLog(eventID int, amount int, occurredAt time.Time, attributes EventAttributes) EventLog {
+	if attributes == nil {
+		attributes = EventAttributes{}
+	}
+
+	return EventLog{
+		EventID:    eventID,
+		Amount:     amount,
+		Attributes: attributes,
+		OccurredAt: occurredAt,
+	}
+}
+
+func TestMissionRuleEvaluate(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	since := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	logs := []EventLog{
+		// 2026-03-02 06:00 in Jakarta.
+		newTestEventLog(1, 2, time.Date(2026, time.March, 1, 23, 0, 0, 0, time.UTC), EventAttributes{"is_important": true, "score": json.Number("10")}),
+		// 2026-03-02 21:00 in Jakarta.
+		newTestEventLog(1, 3, time.Date(2026, time.March, 2, 14, 0, 0, 0, time.UTC), EventAttributes{"is_important": false, "score": json.Number("5")}),
+		// 2026-03-03 07:00 in Jakarta.
+		newTestEventLog(1, 1, time.Date(2026, time.March, 3, 0, 0, 0, 0, time.UTC), EventAttributes{"score": json.Number("2.5")}),
+		newTestEventLog(2, 7, time.Date(2026, time.March, 2, 10, 0, 0, 0, time.UTC), nil),
+		// Before the window starts.
+		newTestEventLog(1, 4, time.Date(2026, time.February, 28, 12, 0, 0, 0, time.UTC), nil),
+	}
+
+	tests := []struct {
+		name     string
+		rule     MissionRule
+		location *time.Location
+		want     int
+	}{
+		{
+			name:     "count",
+			rule:     MissionRule{Event: 1, Aggregation: missionAggregationCount},
+			location: time.UTC,
+			want:     3,
+		},
+		{
+			name:     "count other event",
+			rule:     MissionRule{Event: 2, Aggregation: missionAggregationCount},
+			location: time.UTC,
+			want:     1,
+		},
+		{
+			name:     "count unknown event",
+			rule:     MissionRule{Event: 3, Aggregation: missionAggregationCount},
+			location: time.UTC,
+			want:     0,
+		},
+		{
+			name:     "sum defaults to amount",
+			rule:     MissionRule{Event: 1, Aggregation: missionAggregationSum},
+			location: time.UTC,
+			want:     6,
+		},
+		{
+			name:     "sum attribute",
+			rule:     MissionRule{Event: 1, Aggregation: missionAggregationSum, Field: "score"},
+			location: time.UTC,
+			want:     17,
+		},
+		{
+			name:     "sum skips non-numeric attribute",
+			rule:     MissionRule{Event: 1, Aggregation: missionAggregationSum, Field: "is_important"},
+			location: time.UTC,
+			want:     0,
+		},
+		{
+			name:     "distinct days in utc",
+			rule:     MissionRule{Event: 1, Aggregation: missionAggregationDistinctDays},
+			location: time.UTC,
+			want:     3,
+		},
+		{
+			name:     "distinct days in user time zone",
+			rule:     MissionRule{Event: 1, Aggregation: missionAggregationDistinctDays},
+			location: jakarta,
+			want:     2,
+		},
+		{
+			name:     "weight",
+			rule:     MissionRule{Event: 1, Aggregation: missionAggregationCount, Weight: 5},
+			location: time.UTC,
+			want:     15,
+		},
+		{
+			name:     "negative weight",
+			rule:     MissionRule{Event: 1, Aggregation: missionAggregationSum, Weight: -2},
+			location: time.UTC,
+			want:     -12,
+		},
+		{
+			name: "predicates are combined",
+			rule: MissionRule{
+				Event:       1,
+				Aggregation: missionAggregationCount,
+				Predicates: []MissionPredicate{
+					{Attribute: "score", Operator: "gte", Value: 5},
+					{Attribute: "is_important", Operator: "eq", Value: false},
+				},
+			},
+			location: time.UTC,
+			want:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.evaluate(logs, since, tt.location); got != tt.want {
+				t.Errorf("evaluate() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMissionPredicateMatches(t *testing.T) {
+	log := newTestEventLog(1, 3, time.Now(), EventAttributes{
+		"is_important": true,
+		"completion":   "early",
+		"score":        json.Number("10"),
+		"empty":        nil,
+	})
+
+	tests := []struct {
+		name      string
+		predicate MissionPredicate
+		want      bool
+	}{
+		{name: "eq number", predicate: MissionPredicate{Attribute: "score", Operator: "eq", Value: 10}, want: true},
+		{name: "eq number mismatch", predicate: MissionPredicate{Attribute: "score", Operator: "eq", Value: 11}, want: false},
+		{name: "eq string", predicate: MissionPredicate{Attribute: "completion", Operator: "eq", Value: "early"}, want: true},
+		{name: "eq bool", predicate: MissionPredicate{Attribute: "is_important", Operator: "eq", Value: true}, want: true},
+		{name: "eq amount", predicate: MissionPredicate{Attribute: "amount", Operator: "eq", Value: float64(3)}, want: true},
+		{name: "neq string", predicate: MissionPredicate{Attribute: "completion", Operator: "neq", Value: "late"}, want: true},
+		{name: "neq equal value", predicate: MissionPredicate{Attribute: "completion", Operator: "neq", Value: "early"}, want: false},
+		{name: "neq bool", predicate: MissionPredicate{Attribute: "is_important", Operator: "neq", Value: false}, want: true},
+		{name: "neq missing attribute", predicate: MissionPredicate{Attribute: "missing", Operator: "neq", Value: "late"}, want: true},
+		{name: "neq null attribute", predicate: MissionPredicate{Attribute: "empty", Operator: "neq", Value: "late"}, want: true},
+		{name: "neq mismatched types", predicate: MissionPredicate{Attribute: "completion", Operator: "neq", Value: 1}, want: true},
+		{name: "eq missing attribute", predicate: MissionPredicate{Attribute: "missing", Operator: "eq", Value: "late"}, want: false},
+		{name: "eq mismatched types", predicate: MissionPredicate{Attribute: "completion", Operator: "eq", Value: 1}, want: false},
+		{name: "gt", predicate: MissionPredicate{Attribute: "score", Operator: "gt", Value: 9}, want: true},
+		{name: "gt equal", predicate: MissionPredicate{Attribute: "score", Operator: "gt", Value: 10}, want: false},
+		{name: "gte equal", predicate: MissionPredicate{Attribute: "score", Operator: "gte", Value: 10}, want: true},
+		{name: "gte below", predicate: MissionPredicate{Attribute: "score", Operator: "gte", Value: 11}, want: false},
+		{name: "lt", predicate: MissionPredicate{Attribute: "score", Operator: "lt", Value: 11}, want: true},
+		{name: "lt equal", predicate: MissionPredicate{Attribute: "score", Operator: "lt", Value: 10}, want: false},
+		{name: "lte equal", predicate: MissionPredicate{Attribute: "score", Operator: "lte", Value: 10}, want: true},
+		{name: "lte above", predicate: MissionPredicate{Attribute: "score", Operator: "lte", Value: 9}, want: false},
+		{name: "lt string", predicate: MissionPredicate{Attribute: "completion", Operator: "lt", Value: "late"}, want: true},
+		{name: "gt missing attribute", predicate: MissionPredicate{Attribute: "missing", Operator: "gt", Value: 0}, want: false},
+		{name: "exists", predicate: MissionPredicate{Attribute: "completion", Operator: "exists"}, want: true},
+		{name: "exists missing attribute", predicate: MissionPredicate{Attribute: "missing", Operator: "exists"}, want: false},
+		{name: "exists null attribute", predicate: MissionPredicate{Attribute: "empty", Operator: "exists"}, want: false},
+		{name: "unknown operator", predicate: MissionPredicate{Attribute: "score", Operator: "like", Value: 10}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.predicate.matches(log); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMissionRulesProgress(t *testing.T) {
+	missionStart := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, time.March, 20, 12, 0, 0, 0, time.UTC)
+
+	logs := []EventLog{
+		newTestEventLog(1, 1, time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC), nil),
+		newTestEventLog(1, 1, time.Date(2026, time.March, 10, 9, 0, 0, 0, time.UTC), nil),
+		newTestEventLog(1, 1, time.Date(2026, time.March, 18, 9, 0, 0, 0, time.UTC), nil),
+		newTestEventLog(1, 1, time.Date(2026, time.March, 20, 9, 0, 0, 0, time.UTC), nil),
+		newTestEventLog(2, 1, time.Date(2026, time.March, 19, 9, 0, 0, 0, time.UTC), nil),
+	}
+
+	count := MissionRule{Event: 1, Aggregation: missionAggregationCount}
+
+	tests := []struct {
+		name  string
+		rules MissionRules
+		goal  int
+		want  int
+	}{
+		{
+			name:  "mission window",
+			rules: MissionRules{count},
+			goal:  10,
+			want:  4,
+		},
+		{
+			name:  "explicit mission window",
+			rules: MissionRules{{Event: 1, Aggregation: missionAggregationCount, Window: MissionWindow{Type: missionWindowMission, Days: 3}}},
+			goal:  10,
+			want:  4,
+		},
+		{
+			name:  "rolling window",
+			rules: MissionRules{{Event: 1, Aggregation: missionAggregationCount, Window: MissionWindow{Type: missionWindowRolling, Days: 3}}},
+			goal:  10,
+			want:  2,
+		},
+		{
+			name:  "rolling window without days uses mission start",
+			rules: MissionRules{{Event: 1, Aggregation: missionAggregationCount, Window: MissionWindow{Type: missionWindowRolling}}},
+			goal:  10,
+			want:  4,
+		},
+		{
+			name:  "rules are summed",
+			rules: MissionRules{count, {Event: 2, Aggregation: missionAggregationCount, Weight: 3}},
+			goal:  10,
+			want:  7,
+		},
+		{
+			name:  "negative weight reduces progress",
+			rules: MissionRules{count, {Event: 2, Aggregation: missionAggregationCount, Weight: -2}},
+			goal:  10,
+			want:  2,
+		},
+		{
+			name:  "clamped to zero",
+			rules: MissionRules{count, {Event: 2, Aggregation: missionAggregationCount, Weight: -10}},
+			goal:  10,
+			want:  0,
+		},
+		{
+			name:  "clamped to goal",
+			rules: MissionRules{count},
+			goal:  3,
+			want:  3,
+		},
+		{
+			name:  "no rules",
+			rules: MissionRules{},
+			goal:  3,
+			want:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rules.progress(logs, missionStart, now, time.UTC, tt.goal); got != tt.want {
+				t.Errorf("progress() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMissionWindowStart(t *testing.T) {
+	missionStart := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, time.March, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		window MissionWindow
+		want   time.Time
+	}{
+		{name: "default", window: MissionWindow{}, want: missionStart},
+		{name: "mission", window: MissionWindow{Type: missionWindowMission, Days: 7}, want: missionStart},
+		{name: "rolling", window: MissionWindow{Type: missionWindowRolling, Days: 7}, want: now.AddDate(0, 0, -7)},
+		{name: "rolling is capped", window: MissionWindow{Type: missionWindowRolling, Days: 90}, want: now.AddDate(0, 0, -missionMaxWindowDays)},
+		{name: "rolling without days", window: MissionWindow{Type: missionWindowRolling}, want: missionStart},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.start(missionStart, now); !got.Equal(tt.want) {
+				t.Errorf("start() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   any
+		want   int
+		wantOK bool
+	}{
+		{name: "equal ints", a: 3, b: 3, want: 0, wantOK: true},
+		{name: "int and float", a: 2, b: 2.5, want: -1, wantOK: true},
+		{name: "json number and int", a: json.Number("10"), b: 9, want: 1, wantOK: true},
+		{name: "int64 and int32", a: int64(5), b: int32(5), want: 0, wantOK: true},
+		{name: "strings", a: "early", b: "late", want: -1, wantOK: true},
+		{name: "equal strings", a: "late", b: "late", want: 0, wantOK: true},
+		{name: "equal bools", a: true, b: true, want: 0, wantOK: true},
+		{name: "different bools", a: true, b: false, want: 1, wantOK: true},
+		{name: "number and string", a: 1, b: "1", wantOK: false},
+		{name: "string and bool", a: "true", b: true, wantOK: false},
+		{name: "invalid json number", a: json.Number("abc"), b: 1, wantOK: false},
+		{name: "nil", a: nil, b: nil, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := compareValues(tt.a, tt.b)
+			if ok != tt.wantOK {
+				t.Fatalf("compareValues(%v, %v) ok = %v, want %v", tt.a, tt.b, ok, tt.wantOK)
+			}
+			if ok && got != tt.want {
+				t.Errorf("compareValues(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToNumber(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  any
+		want   float64
+		wantOK bool
+	}{
+		{name: "int", value: 7, want: 7, wantOK: true},
+		{name: "int32", value: int32(-3), want: -3, wantOK: true},
+		{name: "int64", value: int64(1 << 40), want: 1 << 40, wantOK: true},
+		{name: "float64", value: 2.5, want: 2.5, wantOK: true},
+		{name: "json number", value: json.Number("12.75"), want: 12.75, wantOK: true},
+		{name: "invalid json number", value: json.Number("twelve"), wantOK: false},
+		{name: "numeric string", value: "12", wantOK: false},
+		{name: "bool", value: true, wantOK: false},
+		{name: "nil", value: nil, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := toNumber(tt.value)
+			if ok != tt.wantOK {
+				t.Fatalf("toNumber(%v) ok = %v, want %v", tt.value, ok, tt.wantOK)
+			}
+			if ok && got != tt.want {
+				t.Errorf("toNumber(%v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -3,25 +3,36 @@ package app
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	err "github.com/MasLazu/dev-ops-porto/pkg/errors"
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/assignmentservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/genproto/authservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/genproto/googleapis/rpc/code"
 	"google.golang.org/grpc/status"
 )
 
 type Service struct {
-	tracer                trace.Tracer
-	repository            *Repository
-	userRepository        *UserRepository
-	userMissionRepository *UserMissionRepository
-	missionRepository     *MissionRepository
-	authServiceClient     authservice.AuthServiceClient
+	tracer                  trace.Tracer
+	repository              *Repository
+	userRepository          *UserRepository
+	userMissionRepository   *UserMissionRepository
+	missionRepository       *MissionRepository
+	eventLogRepository      *EventLogRepository
+	eventRepository         *EventRepository
+	authServiceClient       authservice.AuthServiceClient
+	assignmentServiceClient assignmentservice.AssignmentServiceClient
+	s3Client                *s3.Client
+	missionImagesBucket     string
+	staticServiceEnpoint    string
 }
 
 func NewService(
@@ -30,20 +41,30 @@ func NewService(
 	userRepository *UserRepository,
 	userMissionRepository *UserMissionRepository,
 	missionRepository *MissionRepository,
+	eventLogRepository *EventLogRepository,
+	eventRepository *EventRepository,
 	authServiceClient authservice.AuthServiceClient,
+	assignmentServiceClient assignmentservice.AssignmentServiceClient,
+	s3Client *s3.Client,
+	missionImagesBucket string,
+	staticServiceEnpoint string,
 ) *Service {
 	return &Service{
-		tracer:                tracer,
-		repository:            repository,
-		userRepository:        userRepository,
-		userMissionRepository: userMissionRepository,
-		missionRepository:     missionRepository,
-		authServiceClient:     authServiceClient,
+		tracer:                  tracer,
+		repository:              repository,
+		userRepository:          userRepository,
+		userMissionRepository:   userMissionRepository,
+		missionRepository:       missionRepository,
+		eventLogRepository:      eventLogRepository,
+		eventRepository:         eventRepository,
+		authServiceClient:       authServiceClient,
+		assignmentServiceClient: assignmentServiceClient,
+		s3Client:                s3Client,
+		missionImagesBucket:     missionImagesBucket,
+		staticServiceEnpoint:    staticServiceEnpoint,
 	}
 }
 
-const missionPeriod = time.Hour * 24
-
 func (s *Service) newError(code code.Code, internalError error) err.ServiceError {
 	return err.NewServiceError(code, internalError)
 }
@@ -93,40 +114,187 @@ func (s *Service) GetUserExpirationMissionDate(ctx context.Context, userID strin
 	return user.ExpirationDate, nil
 }
 
-func (s *Service) TriggerMissionEvent(ctx context.Context, userID string, triggerMissionEvent missionservice.TriggerMissionEvent) error {
-	_, span := s.tracer.Start(ctx, "Service.TriggerMissionEvent")
+func (s *Service) TriggerMissionEvent(ctx context.Context, userID string, event *missionservice.MissionEvent) error {
+	ctx, span := s.tracer.Start(ctx, "Service.TriggerMissionEvent")
 	defer span.End()
 
-	if _, err := s.SyncUserAndMissions(ctx, userID); err != nil {
+	return s.triggerMissionEvents(ctx, userID, []*missionservice.MissionEvent{event})
+}
+
+func (s *Service) TriggerMissionEvents(ctx context.Context, userID string, events []*missionservice.MissionEvent) error {
+	ctx, span := s.tracer.Start(ctx, "Service.TriggerMissionEvents")
+	defer span.End()
+
+	return s.triggerMissionEvents(ctx, userID, events)
+}
+
+func (s *Service) triggerMissionEvents(ctx context.Context, userID string, events []*missionservice.MissionEvent) error {
+	user, err := s.SyncUserAndMissions(ctx, userID)
+	if err != nil {
 		return err
 	}
 
-	encrease, err := s.userMissionRepository.GetUserMissionsByUserIDAndEncreasorEventIDJoinMission(ctx, userID, int(triggerMissionEvent))
-	if err != nil && err != sql.ErrNoRows {
+	now := time.Now()
+	logs := make([]EventLog, 0, len(events))
+	for _, event := range events {
+		logs = append(logs, newEventLog(userID, event, now))
+	}
+
+	logs, err = s.enabledEventLogs(ctx, logs)
+	if err != nil {
 		return err
 	}
+	if len(logs) == 0 {
+		return nil
+	}
 
-	decrease, err := s.userMissionRepository.GetUserMissionsByUserIDAndDecreasorEventIDJoinMission(ctx, userID, int(triggerMissionEvent))
-	if err != nil && err != sql.ErrNoRows {
+	eventIDs := make([]int, 0, len(logs))
+	for _, eventLog := range logs {
+		eventIDs = append(eventIDs, eventLog.EventID)
+		eventIDs = append(eventIDs, eventCancellations[eventLog.EventID]...)
+	}
+
+	if err := s.eventLogRepository.InsertEventLogs(ctx, logs); err != nil {
+		return err
+	}
+
+	userMissions, err := s.userMissionRepository.GetUserMissionsByUserIDJoinMission(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	affected := make([]UserMission, 0, len(userMissions))
+	ruleEventIDs := make([]int, 0)
+	since := now
+	needsLocation := false
+	for _, um := range userMissions {
+		if um.Claimed || !um.Mission.Rules.references(eventIDs) {
+			continue
+		}
+
+		affected = append(affected, um)
+		for _, eventID := range um.Mission.Rules.eventIDs() {
+			if !slices.Contains(ruleEventIDs, eventID) {
+				ruleEventIDs = append(ruleEventIDs, eventID)
+			}
+		}
+		if start := um.Mission.Rules.earliestStart(um.startedAt(user), now); start.Before(since) {
+			since = start
+		}
+		needsLocation = needsLocation || um.Mission.Rules.usesDistinctDays()
+	}
+
+	if len(affected) == 0 {
+		return nil
+	}
+
+	ruleEventIDs = append(ruleEventIDs, cancellingEventIDs(ruleEventIDs)...)
+	history, err := s.eventLogRepository.GetEventLogsByUserIDSince(ctx, userID, since, ruleEventIDs)
+	if err != nil {
+		return err
+	}
+	history = cancelEventLogs(history)
+
+	location := time.UTC
+	if needsLocation {
+		location = s.userLocation(ctx, userID)
+	}
+
+	previousProgress := make(map[int]int)
+	updated := make([]UserMission, 0, len(affected))
+	for _, um := range affected {
+		progress := um.progress(history, user, now, location)
+		if progress == um.Progress {
+			continue
+		}
+
+		previousProgress[um.ID] = um.Progress
+		um.Progress = progress
+		updated = append(updated, um)
+	}
+
+	if err := s.userMissionRepository.UpdateUserMissions(ctx, updated); err != nil {
 		return err
 	}
 
-	userMissions := make([]UserMission, 0, len(encrease)+len(decrease))
-	for _, um := range encrease {
-		if um.Progress < um.Mission.Goal {
-			um.Progress++
+	for _, um := range updated {
+		s.publishMissionEvent(ctx, missionEventProgress, um)
+		if previousProgress[um.ID] < um.Mission.Goal && um.Progress >= um.Mission.Goal {
+			s.publishMissionEvent(ctx, missionEventCompleted, um)
 		}
-		userMissions = append(userMissions, um)
 	}
 
-	for _, um := range decrease {
-		if um.Progress > 0 {
-			um.Progress--
+	return nil
+}
+
+func (s *Service) enabledEventLogs(ctx context.Context, logs []EventLog) ([]EventLog, error) {
+	eventIDs := make([]int, 0, len(logs))
+	for _, eventLog := range logs {
+		if !slices.Contains(eventIDs, eventLog.EventID) {
+			eventIDs = append(eventIDs, eventLog.EventID)
+		}
+	}
+
+	events, err := s.eventRepository.GetEventsByIDs(ctx, eventIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := make(map[int]bool, len(events))
+	for _, event := range events {
+		enabled[event.ID] = event.Enabled
+	}
+
+	unknown := make([]int, 0)
+	for _, eventID := range eventIDs {
+		if _, ok := enabled[eventID]; !ok {
+			unknown = append(unknown, eventID)
 		}
-		userMissions = append(userMissions, um)
+	}
+	if len(unknown) > 0 {
+		return nil, s.newErrorWithCLientMessage(
+			code.Code_INVALID_ARGUMENT,
+			fmt.Errorf("mission events %v are not in the events catalog", unknown),
+			"unknown mission event",
+		)
+	}
+
+	result := make([]EventLog, 0, len(logs))
+	for _, eventLog := range logs {
+		if enabled[eventLog.EventID] {
+			result = append(result, eventLog)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) publishMissionEvent(ctx context.Context, event string, userMission UserMission) {
+	_, span := s.tracer.Start(ctx, "Service.publishMissionEvent")
+	defer span.End()
+
+	payload, err := json.Marshal(missionEventPayload{
+		UserMissionID: userMission.ID,
+		MissionID:     userMission.MissionID,
+		Title:         userMission.Mission.Title,
+		Progress:      userMission.Progress,
+		Goal:          userMission.Mission.Goal,
+		Reward:        userMission.Mission.Reward,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return
 	}
 
-	return s.userMissionRepository.UpdateUserMissions(ctx, userMissions)
+	_, err = s.assignmentServiceClient.PublishUserEvent(ctx, &assignmentservice.PublishUserEventRequest{
+		UserId:  userMission.UserID,
+		Event:   event,
+		Payload: payload,
+	})
+	if err != nil {
+		span.RecordError(err)
+		log.Printf("Error: failed to publish %s event: %v", event, err)
+	}
 }
 
 func (s *Service) SyncUserAndMissions(ctx context.Context, userID string) (User, error) {
@@ -176,7 +344,7 @@ func (s *Service) createUserIfNotExists(ctx context.Context, tx *sql.Tx, userID
 
 	user, err = s.userRepository.InsertUserWithTransaction(ctx, tx, User{
 		ID:             userID,
-		ExpirationDate: time.Now().Add(missionPeriod),
+		ExpirationDate: s.nextMissionReset(ctx, userID),
 	})
 	if err != nil {
 		return user, err
@@ -187,11 +355,37 @@ func (s *Service) createUserIfNotExists(ctx context.Context, tx *sql.Tx, userID
 	return user, err
 }
 
+func (s *Service) userLocation(ctx context.Context, userID string) *time.Location {
+	ctx, span := s.tracer.Start(ctx, "Service.userLocation")
+	defer span.End()
+
+	preferences, err := s.authServiceClient.GetUserPreferences(ctx, &authservice.GetUserByIDRequest{UserId: userID})
+	if err != nil {
+		span.RecordError(err)
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(preferences.Timezone)
+	if err != nil {
+		span.RecordError(err)
+		return time.UTC
+	}
+
+	return location
+}
+
+func (s *Service) nextMissionReset(ctx context.Context, userID string) time.Time {
+	now := time.Now().In(s.userLocation(ctx, userID))
+	year, month, day := now.Date()
+
+	return time.Date(year, month, day+1, 0, 0, 0, 0, now.Location()).UTC()
+}
+
 func (s *Service) resetUserMissions(ctx context.Context, tx *sql.Tx, user User) (User, error) {
 	ctx, span := s.tracer.Start(ctx, "Service.resetUserMissions")
 	defer span.End()
 
-	user.ExpirationDate = time.Now().Add(missionPeriod)
+	user.ExpirationDate = s.nextMissionReset(ctx, user.ID)
 
 	user, err := s.userRepository.UpdateUserWithTransaction(ctx, tx, user)
 	if err != nil {
@@ -203,6 +397,11 @@ func (s *Service) resetUserMissions(ctx context.Context, tx *sql.Tx, user User)
 		return user, err
 	}
 
+	err = s.eventLogRepository.DeleteEventLogsByUserIDBeforeWithTransaction(ctx, tx, user.ID, time.Now().Add(-eventLogRetention))
+	if err != nil {
+		return user, err
+	}
+
 	missionsIDs, err := s.missionRepository.GetTwoRandomMissionIDs(ctx)
 	if err != nil {
 		return user, err
@@ -274,5 +473,7 @@ func (s *Service) CaimMissionReward(ctx context.Context, userMissionID int, user
 		return s.newInternalError(err)
 	}
 
+	s.publishMissionEvent(ctx, missionEventClaimed, userMission)
+
 	return nil
 }
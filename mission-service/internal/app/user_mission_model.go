@@ -1,16 +1,50 @@
 package app
 
+import "time"
+
+const (
+	missionEventCompleted = "mission.completed"
+	missionEventClaimed   = "mission.claimed"
+	missionEventProgress  = "mission.progress"
+)
+
 type UserMission struct {
-	ID        int     `json:"id"`
-	UserID    string  `json:"user_id"`
-	MissionID int     `json:"mission_id"`
-	Progress  int     `json:"progress"`
-	Claimed   bool    `json:"claimed"`
-	CreatedAt string  `json:"created_at"`
-	UpdatedAt string  `json:"updated_at"`
-	Mission   Mission `json:"mission"`
+	ID             int     `json:"id"`
+	UserID         string  `json:"user_id"`
+	MissionID      int     `json:"mission_id"`
+	Progress       int     `json:"progress"`
+	ProgressOffset int     `json:"-"`
+	Claimed        bool    `json:"claimed"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+	Mission        Mission `json:"mission"`
+}
+
+func (um UserMission) startedAt(user User) time.Time {
+	if createdAt, err := time.Parse(time.RFC3339Nano, um.CreatedAt); err == nil {
+		return createdAt
+	}
+
+	return user.ExpirationDate.AddDate(0, 0, -1)
+}
+
+// progress recomputes the mission's progress from the event history on top
+// of the progress it carried over from before events were logged.
+func (um UserMission) progress(logs []EventLog, user User, now time.Time, location *time.Location) int {
+	total := um.ProgressOffset + um.Mission.Rules.total(logs, um.startedAt(user), now, location)
+
+	return min(max(total, 0), um.Mission.Goal)
 }
 
 type ClaimUserMissionRequest struct {
 	UserMissionID int `json:"user_mission_id"`
 }
+
+type missionEventPayload struct {
+	UserMissionID int    `json:"user_mission_id"`
+	MissionID     int    `json:"mission_id"`
+	Title         string `json:"title"`
+	Progress      int    `json:"progress"`
+	Goal          int    `json:"goal"`
+	Reward        int    `json:"reward"`
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUserMissionProgress(t *testing.T) {
+	missionStart := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, time.March, 1, 18, 0, 0, 0, time.UTC)
+	user := User{ExpirationDate: missionStart.AddDate(0, 0, 1)}
+
+	done := MissionRule{Event: 1, Aggregation: missionAggregationCount}
+	undone := MissionRule{Event: 2, Aggregation: missionAggregationCount, Weight: -1}
+	mission := Mission{Rules: MissionRules{done, undone}, Goal: 5}
+
+	logs := func(events ...int) []EventLog {
+		logs := make([]EventLog, len(events))
+		for i, eventID := range events {
+			logs[i] = newTestEventLog(eventID, 1, missionStart.Add(time.Duration(i+1)*time.Hour), nil)
+		}
+		return logs
+	}
+
+	tests := []struct {
+		name      string
+		createdAt string
+		offset    int
+		history   []EventLog
+		want      int
+	}{
+		{name: "migrated progress without new events", offset: 3, want: 3},
+		{name: "new events add to migrated progress", offset: 3, history: logs(1), want: 4},
+		{name: "migrated progress is clamped to goal", offset: 4, history: logs(1, 1, 1), want: 5},
+		{name: "undoing migrated progress", offset: 3, history: logs(2), want: 2},
+		{name: "migrated progress is clamped to zero", offset: 1, history: logs(2, 2), want: 0},
+		{name: "missions created after the migration have no offset", history: logs(1, 1), want: 2},
+		{name: "mission start from created_at", createdAt: missionStart.Add(90 * time.Minute).Format(time.RFC3339Nano), history: logs(1, 1), want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			um := UserMission{ProgressOffset: tt.offset, CreatedAt: tt.createdAt, Mission: mission}
+
+			if got := um.progress(tt.history, user, now, time.UTC); got != tt.want {
+				t.Errorf("progress() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
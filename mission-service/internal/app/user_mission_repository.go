@@ -131,8 +131,8 @@ func (r *UserMissionRepository) GetUserMissionByIDJoinMission(ctx context.Contex
 	defer span.End()
 
 	query := `
-	SELECT um.id, um.user_id, um.mission_id, um.progress, um.claimed, um.created_at, um.updated_at,
-	m.id, m.title, m.image_path, m.event_encreasor_id, m.event_decreasor_id, m.goal, m.reward, m.created_at, m.updated_at
+	SELECT um.id, um.user_id, um.mission_id, um.progress, um.progress_offset, um.claimed, um.created_at, um.updated_at,
+	m.id, m.title, m.image_path, m.rules, m.goal, m.reward, m.created_at, m.updated_at
 	FROM users_missions um
 	JOIN missions m ON m.id = um.mission_id
 	WHERE um.id = $1
@@ -140,9 +140,9 @@ func (r *UserMissionRepository) GetUserMissionByIDJoinMission(ctx context.Contex
 
 	var um UserMission
 	err := r.db.Pool.QueryRowContext(ctx, query, userMissionID).
-		Scan(&um.ID, &um.UserID, &um.MissionID, &um.Progress, &um.Claimed, &um.CreatedAt, &um.UpdatedAt,
-			&um.Mission.ID, &um.Mission.Title, &um.Mission.ImagePath, &um.Mission.EventEncreasorID,
-			&um.Mission.EventDecreasorID, &um.Mission.Goal, &um.Mission.Reward, &um.Mission.CreatedAt, &um.Mission.UpdatedAt)
+		Scan(&um.ID, &um.UserID, &um.MissionID, &um.Progress, &um.ProgressOffset, &um.Claimed, &um.CreatedAt, &um.UpdatedAt,
+			&um.Mission.ID, &um.Mission.Title, &um.Mission.ImagePath, &um.Mission.Rules,
+			&um.Mission.Goal, &um.Mission.Reward, &um.Mission.CreatedAt, &um.Mission.UpdatedAt)
 
 	return um, err
 }
@@ -152,8 +152,8 @@ func (r *UserMissionRepository) GetUserMissionsByUserIDJoinMission(ctx context.C
 	defer span.End()
 
 	query := `
-	SELECT um.id, um.user_id, um.mission_id, um.progress, um.claimed, um.created_at, um.updated_at,
-	m.id, m.title, m.image_path, m.event_encreasor_id, m.event_decreasor_id, m.goal, m.reward, m.created_at, m.updated_at
+	SELECT um.id, um.user_id, um.mission_id, um.progress, um.progress_offset, um.claimed, um.created_at, um.updated_at,
+	m.id, m.title, m.image_path, m.rules, m.goal, m.reward, m.created_at, m.updated_at
 	FROM users_missions um
 	JOIN missions m ON m.id = um.mission_id
 	WHERE um.user_id = $1
@@ -169,113 +169,15 @@ func (r *UserMissionRepository) GetUserMissionsByUserIDJoinMission(ctx context.C
 
 	for rows.Next() {
 		var um UserMission
-		var eventDecreasorID sql.NullInt32
-		err := rows.Scan(&um.ID, &um.UserID, &um.MissionID, &um.Progress, &um.Claimed, &um.CreatedAt, &um.UpdatedAt,
-			&um.Mission.ID, &um.Mission.Title, &um.Mission.ImagePath, &um.Mission.EventEncreasorID,
-			&eventDecreasorID, &um.Mission.Goal, &um.Mission.Reward, &um.Mission.CreatedAt, &um.Mission.UpdatedAt)
+		err := rows.Scan(&um.ID, &um.UserID, &um.MissionID, &um.Progress, &um.ProgressOffset, &um.Claimed, &um.CreatedAt, &um.UpdatedAt,
+			&um.Mission.ID, &um.Mission.Title, &um.Mission.ImagePath, &um.Mission.Rules,
+			&um.Mission.Goal, &um.Mission.Reward, &um.Mission.CreatedAt, &um.Mission.UpdatedAt)
 		if err != nil {
 			return userMissions, err
 		}
 
-		if eventDecreasorID.Valid {
-			um.Mission.EventDecreasorID = int(eventDecreasorID.Int32)
-		}
-
 		userMissions = append(userMissions, um)
 	}
 
 	return userMissions, nil
 }
-
-func (r *UserMissionRepository) GetUserMissionsByUserIDAndEncreasorEventIDJoinMission(
-	ctx context.Context,
-	userID string,
-	eventID int,
-) ([]UserMission, error) {
-	ctx, span := r.tracer.Start(ctx, "UserMissionRepository.GetUserMissionsByUserIDAndEncreasorEventIDJoinMission")
-	defer span.End()
-
-	missions := []UserMission{}
-
-	query := `
-	SELECT um.id, um.user_id, um.mission_id, um.progress, um.claimed, um.created_at, um.updated_at,
-	m.id, m.title, m.image_path, m.event_encreasor_id, m.event_decreasor_id, m.goal, m.reward, m.created_at, m.updated_at
-	FROM users_missions um
-	JOIN missions m ON m.id = um.mission_id
-	WHERE um.user_id = $1 AND m.event_encreasor_id = $2
-	`
-
-	rows, err := r.db.Pool.QueryContext(ctx, query, userID, eventID)
-	if err != nil {
-		return missions, err
-	}
-	defer rows.Close()
-
-	empty := true
-	for rows.Next() {
-		var um UserMission
-		empty = false
-		err := rows.Scan(&um.ID, &um.UserID, &um.MissionID, &um.Progress, &um.Claimed, &um.CreatedAt, &um.UpdatedAt,
-			&um.Mission.ID, &um.Mission.Title, &um.Mission.ImagePath, &um.Mission.EventEncreasorID,
-			&um.Mission.EventDecreasorID, &um.Mission.Goal, &um.Mission.Reward, &um.Mission.CreatedAt, &um.Mission.UpdatedAt)
-		if err != nil {
-			return missions, err
-		}
-
-		missions = append(missions, um)
-	}
-	if empty {
-		return missions, sql.ErrNoRows
-	}
-
-	return missions, nil
-}
-
-func (r *UserMissionRepository) GetUserMissionsByUserIDAndDecreasorEventIDJoinMission(
-	ctx context.Context,
-	userID string,
-	eventID int,
-) ([]UserMission, error) {
-	ctx, span := r.tracer.Start(ctx, "UserMissionRepository.GetUserMissionsByUserIDAndDecreasorEventIDJoinMission")
-	defer span.End()
-
-	missions := []UserMission{}
-
-	query := `
-	SELECT um.id, um.user_id, um.mission_id, um.progress, um.claimed, um.created_at, um.updated_at, 
-	m.id, m.title, m.image_path, m.event_encreasor_id, m.event_decreasor_id, m.goal, m.reward, m.created_at, m.updated_at
-	FROM users_missions um
-	JOIN missions m ON m.id = um.mission_id
-	WHERE um.user_id = $1 AND m.event_decreasor_id = $2
-	`
-
-	rows, err := r.db.Pool.QueryContext(ctx, query, userID, eventID)
-	if err != nil {
-		return missions, err
-	}
-	defer rows.Close()
-
-	empty := true
-	for rows.Next() {
-		var um UserMission
-		var eventDecreasorID sql.NullInt32
-		empty = false
-		err := rows.Scan(&um.ID, &um.UserID, &um.MissionID, &um.Progress, &um.Claimed, &um.CreatedAt, &um.UpdatedAt,
-			&um.Mission.ID, &um.Mission.Title, &um.Mission.ImagePath, &um.Mission.EventEncreasorID,
-			&eventDecreasorID, &um.Mission.Goal, &um.Mission.Reward, &um.Mission.CreatedAt, &um.Mission.UpdatedAt)
-		if err != nil {
-			return missions, err
-		}
-
-		if eventDecreasorID.Valid {
-			um.Mission.EventDecreasorID = int(eventDecreasorID.Int32)
-		}
-
-		missions = append(missions, um)
-	}
-	if empty {
-		return missions, sql.ErrNoRows
-	}
-
-	return missions, nil
-}
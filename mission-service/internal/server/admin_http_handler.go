@@ -0,0 +1,255 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/MasLazu/dev-ops-porto/mission-service/internal/app"
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/go-chi/chi/v5"
+)
+
+const missionImageMaxSize = 5 << 20
+
+func (h *HttpHandler) adminOnly(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		userID, err := util.GetUserIDFromContext(ctx)
+		if err != nil {
+			h.responseWriter.WriteUnauthorizedResponse(ctx, w)
+			return
+		}
+
+		if !slices.Contains(h.adminUserIDs, userID) {
+			h.responseWriter.WriteErrorResponse(ctx, w, http.StatusForbidden, http.StatusText(http.StatusForbidden))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *HttpHandler) GetEvents(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.GetEvents")
+	defer span.End()
+
+	events, serviceErr := h.service.GetEvents(ctx)
+	if serviceErr != nil {
+		h.responseWriter.WriteErrorResponse(ctx, w, serviceErr.HttpCode(), serviceErr.ClientMessage())
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, events)
+}
+
+func (h *HttpHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.CreateEvent")
+	defer span.End()
+
+	var req app.CreateEventRequest
+	if err := h.requestDecoder.Decode(ctx, r, &req); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if err := h.validator.Validate(ctx, req); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	event, serviceErr := h.service.CreateEvent(ctx, req)
+	if serviceErr != nil {
+		h.responseWriter.WriteErrorResponse(ctx, w, serviceErr.HttpCode(), serviceErr.ClientMessage())
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, event)
+}
+
+func (h *HttpHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.UpdateEvent")
+	defer span.End()
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	var req app.UpdateEventRequest
+	if err := h.requestDecoder.Decode(ctx, r, &req); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if err := h.validator.Validate(ctx, req); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	event, serviceErr := h.service.UpdateEvent(ctx, id, req)
+	if serviceErr != nil {
+		h.responseWriter.WriteErrorResponse(ctx, w, serviceErr.HttpCode(), serviceErr.ClientMessage())
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, event)
+}
+
+func (h *HttpHandler) DeleteEvent(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.DeleteEvent")
+	defer span.End()
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if serviceErr := h.service.DeleteEvent(ctx, id); serviceErr != nil {
+		h.responseWriter.WriteErrorResponse(ctx, w, serviceErr.HttpCode(), serviceErr.ClientMessage())
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, nil)
+}
+
+func (h *HttpHandler) GetMissions(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.GetMissions")
+	defer span.End()
+
+	missions, serviceErr := h.service.GetMissions(ctx)
+	if serviceErr != nil {
+		h.responseWriter.WriteErrorResponse(ctx, w, serviceErr.HttpCode(), serviceErr.ClientMessage())
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, missions)
+}
+
+func (h *HttpHandler) GetMission(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.GetMission")
+	defer span.End()
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	mission, serviceErr := h.service.GetMission(ctx, id)
+	if serviceErr != nil {
+		h.responseWriter.WriteErrorResponse(ctx, w, serviceErr.HttpCode(), serviceErr.ClientMessage())
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, mission)
+}
+
+func (h *HttpHandler) CreateMission(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.CreateMission")
+	defer span.End()
+
+	var req app.MissionRequest
+	if err := h.requestDecoder.Decode(ctx, r, &req); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if err := h.validator.Validate(ctx, req); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	mission, serviceErr := h.service.CreateMission(ctx, req)
+	if serviceErr != nil {
+		h.responseWriter.WriteErrorResponse(ctx, w, serviceErr.HttpCode(), serviceErr.ClientMessage())
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, mission)
+}
+
+func (h *HttpHandler) UpdateMission(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.UpdateMission")
+	defer span.End()
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	var req app.MissionRequest
+	if err := h.requestDecoder.Decode(ctx, r, &req); err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if err := h.validator.Validate(ctx, req); err != nil {
+		h.responseWriter.WriteValidationErrorResponse(ctx, w, *err)
+		return
+	}
+
+	mission, serviceErr := h.service.UpdateMission(ctx, id, req)
+	if serviceErr != nil {
+		h.responseWriter.WriteErrorResponse(ctx, w, serviceErr.HttpCode(), serviceErr.ClientMessage())
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, mission)
+}
+
+func (h *HttpHandler) DeleteMission(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.DeleteMission")
+	defer span.End()
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	if serviceErr := h.service.DeleteMission(ctx, id); serviceErr != nil {
+		h.responseWriter.WriteErrorResponse(ctx, w, serviceErr.HttpCode(), serviceErr.ClientMessage())
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, nil)
+}
+
+func (h *HttpHandler) UploadMissionImage(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.UploadMissionImage")
+	defer span.End()
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, missionImageMaxSize)
+	fileMultipart, _, err := r.FormFile("image")
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+	defer fileMultipart.Close()
+
+	file, err := io.ReadAll(fileMultipart)
+	if err != nil {
+		h.responseWriter.WriteBadRequestResponse(ctx, w)
+		return
+	}
+
+	mission, serviceErr := h.service.UploadMissionImage(ctx, id, file)
+	if serviceErr != nil {
+		h.responseWriter.WriteErrorResponse(ctx, w, serviceErr.HttpCode(), serviceErr.ClientMessage())
+		return
+	}
+
+	h.responseWriter.WriteSuccessResponse(ctx, w, mission)
+}
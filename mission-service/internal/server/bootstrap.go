@@ -3,16 +3,24 @@ package server
 import (
 	"github.com/MasLazu/dev-ops-porto/mission-service/internal/app"
 	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/assignmentservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/genproto/authservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/middleware"
 	"github.com/MasLazu/dev-ops-porto/pkg/monitoring"
 	"github.com/MasLazu/dev-ops-porto/pkg/server"
 	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"go.opentelemetry.io/otel"
 )
 
-func bootstrap(config config, db *database.Service, logger *monitoring.Logger, authServiceClient authservice.AuthServiceClient) (*server.HttpServer, *server.GrpcServer) {
+func bootstrap(config config, db *database.Service, logger *monitoring.Logger, authServiceClient authservice.AuthServiceClient, assignmentServiceClient assignmentservice.AssignmentServiceClient) (*server.HttpServer, *server.GrpcServer, *app.Service) {
+	s3Client := s3.NewFromConfig(config.aws.awsConfig, func(o *s3.Options) {
+		o.UsePathStyle = true
+		o.BaseEndpoint = aws.String(config.aws.s3.enpoint)
+	})
+
 	tracer := otel.Tracer(config.serviceName)
 
 	responseWriter := util.NewResponseWriter(tracer)
@@ -24,6 +32,8 @@ func bootstrap(config config, db *database.Service, logger *monitoring.Logger, a
 	userRepository := app.NewUserRepository(db)
 	userMissionRepository := app.NewUserMissionRepository(db, tracer)
 	missionRepository := app.NewMissionRepository(db, tracer)
+	eventLogRepository := app.NewEventLogRepository(db, tracer)
+	eventRepository := app.NewEventRepository(db, tracer)
 
 	service := app.NewService(
 		tracer,
@@ -31,12 +41,18 @@ func bootstrap(config config, db *database.Service, logger *monitoring.Logger, a
 		userRepository,
 		userMissionRepository,
 		missionRepository,
+		eventLogRepository,
+		eventRepository,
 		authServiceClient,
+		assignmentServiceClient,
+		s3Client,
+		config.aws.s3.bucketNames.missionImages,
+		config.staticServiceEnpoint,
 	)
 
 	authMiddleware := middleware.NewAuthMiddleware(config.jwtSecret, responseWriter, handlerTracer)
 
-	httpHandler := NewHttpHandler(tracer, responseWriter, requestDecoder, validator, handlerTracer, service, authMiddleware)
+	httpHandler := NewHttpHandler(tracer, responseWriter, requestDecoder, validator, handlerTracer, service, authMiddleware, config.adminUserIDs)
 	GrpcHandler := NewGrpcHandler(tracer, service)
 
 	httpServer := server.NewHttpServer(server.HttpServerConfig{
@@ -50,5 +66,5 @@ func bootstrap(config config, db *database.Service, logger *monitoring.Logger, a
 	}, logger)
 	missionservice.RegisterMissionServiceServer(grpcServer.Server, GrpcHandler)
 
-	return httpServer, grpcServer
+	return httpServer, grpcServer, service
 }
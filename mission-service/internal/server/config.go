@@ -1,24 +1,47 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 )
 
+type bucketNames struct {
+	missionImages string
+}
+
+type s3Config struct {
+	enpoint     string
+	bucketNames bucketNames
+}
+
+type AwsConfig struct {
+	awsConfig aws.Config
+	s3        s3Config
+}
+
 type config struct {
-	httpPort              int
-	grpcPort              int
-	otlpDomain            string
-	database              database.Config
-	serviceName           string
-	jwtSecret             []byte
-	grpcAuthServiceDomain string
+	httpPort                    int
+	grpcPort                    int
+	otlpDomain                  string
+	database                    database.Config
+	serviceName                 string
+	jwtSecret                   []byte
+	grpcAuthServiceDomain       string
+	grpcAssignmentServiceDomain string
+	aws                         AwsConfig
+	staticServiceEnpoint        string
+	adminUserIDs                []string
 }
 
-func getConfig() (config, error) {
+func getConfig(ctx context.Context) (config, error) {
 	httpPort, err := getIntEnv("HTTP_PORT")
 	if err != nil {
 		return config{}, err
@@ -34,14 +57,31 @@ func getConfig() (config, error) {
 		return config{}, fmt.Errorf("failed to get database config: %w", err)
 	}
 
+	awsConfig, err := getAwsConfig(ctx)
+	if err != nil {
+		return config{}, fmt.Errorf("failed to get S3 config: %w", err)
+	}
+
 	return config{
-		httpPort:              httpPort,
-		grpcPort:              grpcPort,
-		otlpDomain:            os.Getenv("OTLP_DOMAIN"),
-		jwtSecret:             []byte(os.Getenv("JWT_SECRET")),
-		serviceName:           "mission-service",
-		grpcAuthServiceDomain: os.Getenv("GRPC_AUTH_SERVICE_DOMAIN"),
-		database:              dbConfig,
+		httpPort:                    httpPort,
+		grpcPort:                    grpcPort,
+		otlpDomain:                  os.Getenv("OTLP_DOMAIN"),
+		jwtSecret:                   []byte(os.Getenv("JWT_SECRET")),
+		serviceName:                 "mission-service",
+		grpcAuthServiceDomain:       os.Getenv("GRPC_AUTH_SERVICE_DOMAIN"),
+		grpcAssignmentServiceDomain: os.Getenv("GRPC_ASSIGNMENT_SERVICE_DOMAIN"),
+		database:                    dbConfig,
+		staticServiceEnpoint:        os.Getenv("PUBLIC_STATIC_SERVICE_ENDPOINT"),
+		adminUserIDs:                getListEnv("ADMIN_USER_IDS"),
+		aws: AwsConfig{
+			awsConfig: awsConfig,
+			s3: s3Config{
+				enpoint: os.Getenv("S3_ENDPOINT"),
+				bucketNames: bucketNames{
+					missionImages: os.Getenv("S3_BUCKET_MISSION_IMAGES"),
+				},
+			},
+		},
 	}, nil
 }
 
@@ -56,6 +96,29 @@ func getIntEnv(key string) (int, error) {
 	return i, nil
 }
 
+func getListEnv(key string) []string {
+	values := make([]string, 0)
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+func getAwsConfig(ctx context.Context) (aws.Config, error) {
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx,
+		awsconfig.WithRegion("us-west-2"),
+		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(os.Getenv("S3_ACCESS_KEY"), os.Getenv("S3_SECRET_KEY"), "")),
+	)
+	if err != nil {
+		return aws.Config{}, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	return awsCfg, nil
+}
+
 func getDatabaseConfig() (database.Config, error) {
 	port, err := strconv.Atoi(os.Getenv("DB_PORT"))
 	if err != nil {
@@ -2,12 +2,16 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	"github.com/MasLazu/dev-ops-porto/mission-service/internal/app"
+	serviceerrors "github.com/MasLazu/dev-ops-porto/pkg/errors"
 	"github.com/MasLazu/dev-ops-porto/pkg/genproto/missionservice"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type GrpcHandler struct {
@@ -33,10 +37,59 @@ func (h *GrpcHandler) TriggerMissionEvent(ctx context.Context, req *missionservi
 		return res, status.Error(codes.InvalidArgument, "unknown mission event")
 	}
 
-	err := h.service.TriggerMissionEvent(ctx, req.UserId, req.Event)
+	err := h.service.TriggerMissionEvent(ctx, req.UserId, &missionservice.MissionEvent{
+		EventId:    req.EventId,
+		Event:      req.Event,
+		Amount:     req.Amount,
+		OccurredAt: req.OccurredAt,
+		SourceId:   req.SourceId,
+		Attributes: req.Attributes,
+	})
 	if err != nil {
-		return res, status.Error(codes.Internal, err.Error())
+		return res, grpcError(err)
 	}
 
 	return res, nil
 }
+
+func (h *GrpcHandler) TriggerMissionEvents(ctx context.Context, req *missionservice.TriggerMissionEventsRequest) (*missionservice.TriggerMissionEventsResponse, error) {
+	_, span := h.tracer.Start(ctx, "GrpcHandler.TriggerMissionEvents")
+	defer span.End()
+
+	res := &missionservice.TriggerMissionEventsResponse{}
+
+	// Deprecated events carry no id or timestamp, so each one gets a fresh id
+	// and the time the server received it.
+	events := req.MissionEvents
+	receivedAt := timestamppb.Now()
+	for _, event := range req.Events {
+		events = append(events, &missionservice.MissionEvent{
+			EventId:    uuid.NewString(),
+			Event:      event,
+			Amount:     1,
+			OccurredAt: receivedAt,
+		})
+	}
+
+	for _, event := range events {
+		if event.Event == missionservice.TriggerMissionEvent_MISSION_EVENT_UNKNOWN {
+			return res, status.Error(codes.InvalidArgument, "unknown mission event")
+		}
+	}
+
+	err := h.service.TriggerMissionEvents(ctx, req.UserId, events)
+	if err != nil {
+		return res, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func grpcError(err error) error {
+	var serviceError serviceerrors.ServiceError
+	if errors.As(err, &serviceError) {
+		return status.Error(serviceError.GrpcCode(), serviceError.ClientMessage())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
@@ -19,6 +19,7 @@ type HttpHandler struct {
 	handlerTracer  *util.HandlerTracer
 	service        *app.Service
 	authMiddleware *middleware.AuthMiddleware
+	adminUserIDs   []string
 }
 
 func NewHttpHandler(
@@ -29,6 +30,7 @@ func NewHttpHandler(
 	handlerTracer *util.HandlerTracer,
 	service *app.Service,
 	authMiddleware *middleware.AuthMiddleware,
+	adminUserIDs []string,
 ) *HttpHandler {
 	return &HttpHandler{
 		tracer:         tracer,
@@ -38,6 +40,7 @@ func NewHttpHandler(
 		handlerTracer:  handlerTracer,
 		service:        service,
 		authMiddleware: authMiddleware,
+		adminUserIDs:   adminUserIDs,
 	}
 }
 
@@ -51,6 +54,21 @@ func (h *HttpHandler) SetupRoutes(c *chi.Mux) http.Handler {
 		c.Post("/claim", h.ClaimMission)
 	})
 
+	c.Route("/admin", func(c chi.Router) {
+		c.Use(h.authMiddleware.Auth)
+		c.Use(h.adminOnly)
+		c.Get("/events", h.GetEvents)
+		c.Post("/events", h.CreateEvent)
+		c.Put("/events/{id}", h.UpdateEvent)
+		c.Delete("/events/{id}", h.DeleteEvent)
+		c.Get("/missions", h.GetMissions)
+		c.Post("/missions", h.CreateMission)
+		c.Get("/missions/{id}", h.GetMission)
+		c.Put("/missions/{id}", h.UpdateMission)
+		c.Delete("/missions/{id}", h.DeleteMission)
+		c.Post("/missions/{id}/image", h.UploadMissionImage)
+	})
+
 	return c
 }
 
@@ -9,6 +9,7 @@ import (
 	"sync"
 
 	"github.com/MasLazu/dev-ops-porto/pkg/database"
+	"github.com/MasLazu/dev-ops-porto/pkg/genproto/assignmentservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/genproto/authservice"
 	"github.com/MasLazu/dev-ops-porto/pkg/monitoring"
 	"github.com/MasLazu/dev-ops-porto/pkg/util"
@@ -19,7 +20,7 @@ func Run(ctx context.Context) (err error) {
 	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt)
 	defer cancel()
 
-	config, err := getConfig()
+	config, err := getConfig(ctx)
 	if err != nil {
 		return
 	}
@@ -58,7 +59,23 @@ func Run(ctx context.Context) (err error) {
 
 	authServiceClient := authservice.NewAuthServiceClient(authServiceConn)
 
-	httpServer, grpcServer := bootstrap(config, db, logger, authServiceClient)
+	assignmentServiceConn, err := util.NewGRPCClient(ctx, config.grpcAssignmentServiceDomain, logger)
+	if err != nil {
+		logger.Error(ctx, fmt.Sprintf("Failed to connect to gRPC server: %v", err), log.String("address", config.grpcAssignmentServiceDomain))
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, assignmentServiceConn.Close())
+	}()
+
+	assignmentServiceClient := assignmentservice.NewAssignmentServiceClient(assignmentServiceConn)
+
+	httpServer, grpcServer, service := bootstrap(config, db, logger, authServiceClient, assignmentServiceClient)
+
+	if err = service.CheckEventCatalog(ctx); err != nil {
+		logger.Error(ctx, fmt.Sprintf("Event catalog does not match the mission events: %v", err))
+		return
+	}
 
 	var wg sync.WaitGroup
 	wg.Add(2)
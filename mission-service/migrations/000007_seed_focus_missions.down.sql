@@ -0,0 +1,5 @@
+DELETE FROM users_missions WHERE mission_id IN (7, 8);
+
+DELETE FROM missions WHERE id IN (7, 8);
+
+DELETE FROM events WHERE id = 5;
\ No newline at end of file
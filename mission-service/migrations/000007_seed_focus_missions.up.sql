@@ -0,0 +1,35 @@
+INSERT INTO
+    events (id, name)
+VALUES (
+        5,
+        'MISSION_EVENT_FOCUS_SESSION_COMPLETED'
+    );
+
+INSERT INTO
+    missions (
+        id,
+        title,
+        image_path,
+        reward,
+        goal,
+        event_encreasor_id,
+        event_decreasor_id
+    )
+VALUES (
+        7,
+        'Focus for 25 minutes today to get 20 coins',
+        'https://remi.agileteknik.com/assets/burning-hand-BEIzgNoa.svg',
+        20,
+        25,
+        5,
+        NULL
+    ),
+    (
+        8,
+        'Focus for 50 minutes today to get 40 coins',
+        'https://remi.agileteknik.com/assets/burning-hand-BEIzgNoa.svg',
+        40,
+        50,
+        5,
+        NULL
+    );
\ No newline at end of file
@@ -0,0 +1,26 @@
+ALTER TABLE missions
+ADD COLUMN event_encreasor_id INTEGER REFERENCES events (id),
+ADD COLUMN event_decreasor_id INTEGER REFERENCES events (id);
+
+UPDATE missions
+SET
+    event_encreasor_id = (
+        SELECT (rule ->> 'event')::integer
+        FROM jsonb_array_elements(rules) rule
+        WHERE COALESCE((rule ->> 'weight')::integer, 1) > 0
+        LIMIT 1
+    ),
+    event_decreasor_id = (
+        SELECT (rule ->> 'event')::integer
+        FROM jsonb_array_elements(rules) rule
+        WHERE (rule ->> 'weight')::integer < 0
+        LIMIT 1
+    );
+
+DELETE FROM users_missions WHERE mission_id IN (SELECT id FROM missions WHERE event_encreasor_id IS NULL);
+
+DELETE FROM missions WHERE event_encreasor_id IS NULL;
+
+ALTER TABLE missions ALTER COLUMN event_encreasor_id SET NOT NULL;
+
+ALTER TABLE missions DROP COLUMN rules;
\ No newline at end of file
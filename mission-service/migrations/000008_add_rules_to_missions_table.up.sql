@@ -0,0 +1,15 @@
+ALTER TABLE missions ADD COLUMN rules JSONB NOT NULL DEFAULT '[]';
+
+UPDATE missions
+SET rules = jsonb_build_array(
+        jsonb_build_object('event', event_encreasor_id, 'aggregation', 'sum', 'weight', 1)
+    ) || CASE
+        WHEN event_decreasor_id IS NULL THEN '[]'::jsonb
+        ELSE jsonb_build_array(
+            jsonb_build_object('event', event_decreasor_id, 'aggregation', 'sum', 'weight', -1)
+        )
+    END;
+
+ALTER TABLE missions
+DROP COLUMN event_encreasor_id,
+DROP COLUMN event_decreasor_id;
\ No newline at end of file
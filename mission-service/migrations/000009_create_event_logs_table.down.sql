@@ -0,0 +1 @@
+DROP TABLE IF EXISTS event_logs;
\ No newline at end of file
@@ -0,0 +1,10 @@
+CREATE TABLE event_logs (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    event_id INTEGER NOT NULL REFERENCES events (id),
+    amount INTEGER NOT NULL DEFAULT 1,
+    attributes JSONB NOT NULL DEFAULT '{}',
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX event_logs_user_id_occurred_at_idx ON event_logs (user_id, occurred_at);
\ No newline at end of file
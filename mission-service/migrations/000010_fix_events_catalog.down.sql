@@ -0,0 +1,17 @@
+UPDATE missions
+SET rules = (
+        SELECT jsonb_agg(
+                CASE
+                    WHEN (rule ->> 'event')::integer = 4 THEN jsonb_set(rule, '{event}', '3')
+                    ELSE rule
+                END
+            )
+        FROM jsonb_array_elements(rules) rule
+    )
+WHERE rules @> '[{"event": 4}]';
+
+DELETE FROM event_logs WHERE event_id IN (3, 4);
+
+DELETE FROM events WHERE id = 4;
+
+UPDATE events SET name = 'MISSION_EVENT_DELETE_ASSIGNMENT' WHERE id = 3;
\ No newline at end of file
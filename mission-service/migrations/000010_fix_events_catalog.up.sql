@@ -0,0 +1,25 @@
+UPDATE events SET name = 'MISSION_EVENT_UNDONE_ASSIGNMENT' WHERE id = 3;
+
+INSERT INTO
+    events (id, name)
+VALUES (
+        4,
+        'MISSION_EVENT_DELETE_ASSIGNMENT'
+    )
+ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name;
+
+UPDATE missions
+SET rules = (
+        SELECT jsonb_agg(
+                CASE
+                    WHEN (rule ->> 'event')::integer = 3 THEN jsonb_set(rule, '{event}', '4')
+                    ELSE rule
+                END
+            )
+        FROM jsonb_array_elements(rules) rule
+    )
+WHERE rules @> '[{"event": 3}]';
+
+SELECT setval(pg_get_serial_sequence('events', 'id'), (SELECT MAX(id) FROM events));
+
+SELECT setval(pg_get_serial_sequence('missions', 'id'), (SELECT MAX(id) FROM missions));
\ No newline at end of file
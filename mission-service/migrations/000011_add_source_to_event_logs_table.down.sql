@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS event_logs_user_id_external_id_idx;
+
+ALTER TABLE event_logs
+DROP COLUMN external_id,
+DROP COLUMN source_id;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE event_logs
+ADD COLUMN external_id VARCHAR(255),
+ADD COLUMN source_id VARCHAR(255);
+
+CREATE UNIQUE INDEX event_logs_user_id_external_id_idx ON event_logs (user_id, external_id);
\ No newline at end of file
@@ -0,0 +1,9 @@
+ALTER TABLE missions
+DROP COLUMN enabled,
+DROP COLUMN image_key;
+
+ALTER TABLE events
+DROP COLUMN description,
+DROP COLUMN enabled,
+DROP COLUMN created_at,
+DROP COLUMN updated_at;
\ No newline at end of file
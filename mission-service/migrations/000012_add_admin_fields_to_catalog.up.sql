@@ -0,0 +1,9 @@
+ALTER TABLE events
+ADD COLUMN description VARCHAR(255) NOT NULL DEFAULT '',
+ADD COLUMN enabled BOOLEAN NOT NULL DEFAULT TRUE,
+ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+ALTER TABLE missions
+ADD COLUMN enabled BOOLEAN NOT NULL DEFAULT TRUE,
+ADD COLUMN image_key VARCHAR(255);
\ No newline at end of file
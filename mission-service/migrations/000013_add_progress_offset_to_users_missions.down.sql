@@ -0,0 +1 @@
+ALTER TABLE users_missions DROP COLUMN IF EXISTS progress_offset;
\ No newline at end of file
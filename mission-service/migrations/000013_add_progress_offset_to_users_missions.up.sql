@@ -0,0 +1,6 @@
+ALTER TABLE users_missions ADD COLUMN progress_offset INTEGER NOT NULL DEFAULT 0;
+
+UPDATE users_missions um
+SET progress_offset = um.progress
+WHERE um.claimed = FALSE
+AND NOT EXISTS (SELECT 1 FROM event_logs el WHERE el.user_id = um.user_id);
\ No newline at end of file
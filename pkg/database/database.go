@@ -26,8 +26,9 @@ type Config struct {
 }
 
 type Service struct {
-	Pool   *sql.DB
-	config Config
+	Pool    *sql.DB
+	config  Config
+	connStr string
 }
 
 func New(config Config) (*Service, error) {
@@ -50,8 +51,9 @@ func New(config Config) (*Service, error) {
 	}
 
 	return &Service{
-		Pool:   db,
-		config: config,
+		Pool:    db,
+		config:  config,
+		connStr: connStr,
 	}, nil
 }
 
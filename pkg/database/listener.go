@@ -0,0 +1,29 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *Service) Listen(ctx context.Context, channel string, handle func(payload string)) error {
+	conn, err := pgx.Connect(ctx, s.connStr)
+	if err != nil {
+		return fmt.Errorf("failed to connect listener: %w", err)
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", channel, err)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		handle(notification.Payload)
+	}
+}
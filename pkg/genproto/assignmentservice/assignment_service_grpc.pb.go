@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: assignment_service.proto
+
+package assignmentservice
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AssignmentService_GetAssignment_FullMethodName     = "/AssignmentService/GetAssignment"
+	AssignmentService_ListAssignments_FullMethodName   = "/AssignmentService/ListAssignments"
+	AssignmentService_CountAssignments_FullMethodName  = "/AssignmentService/CountAssignments"
+	AssignmentService_ExportAssignments_FullMethodName = "/AssignmentService/ExportAssignments"
+	AssignmentService_PublishUserEvent_FullMethodName  = "/AssignmentService/PublishUserEvent"
+)
+
+// AssignmentServiceClient is the client API for AssignmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AssignmentServiceClient interface {
+	GetAssignment(ctx context.Context, in *GetAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error)
+	ListAssignments(ctx context.Context, in *ListAssignmentsRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error)
+	CountAssignments(ctx context.Context, in *CountAssignmentsRequest, opts ...grpc.CallOption) (*CountAssignmentsResponse, error)
+	ExportAssignments(ctx context.Context, in *ExportAssignmentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Assignment], error)
+	PublishUserEvent(ctx context.Context, in *PublishUserEventRequest, opts ...grpc.CallOption) (*PublishUserEventResponse, error)
+}
+
+type assignmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAssignmentServiceClient(cc grpc.ClientConnInterface) AssignmentServiceClient {
+	return &assignmentServiceClient{cc}
+}
+
+func (c *assignmentServiceClient) GetAssignment(ctx context.Context, in *GetAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignment)
+	err := c.cc.Invoke(ctx, AssignmentService_GetAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) ListAssignments(ctx context.Context, in *ListAssignmentsRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssignmentsResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_ListAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) CountAssignments(ctx context.Context, in *CountAssignmentsRequest, opts ...grpc.CallOption) (*CountAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountAssignmentsResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_CountAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) ExportAssignments(ctx context.Context, in *ExportAssignmentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Assignment], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AssignmentService_ServiceDesc.Streams[0], AssignmentService_ExportAssignments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportAssignmentsRequest, Assignment]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AssignmentService_ExportAssignmentsClient = grpc.ServerStreamingClient[Assignment]
+
+func (c *assignmentServiceClient) PublishUserEvent(ctx context.Context, in *PublishUserEventRequest, opts ...grpc.CallOption) (*PublishUserEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishUserEventResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_PublishUserEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AssignmentServiceServer is the server API for AssignmentService service.
+// All implementations must embed UnimplementedAssignmentServiceServer
+// for forward compatibility.
+type AssignmentServiceServer interface {
+	GetAssignment(context.Context, *GetAssignmentRequest) (*Assignment, error)
+	ListAssignments(context.Context, *ListAssignmentsRequest) (*ListAssignmentsResponse, error)
+	CountAssignments(context.Context, *CountAssignmentsRequest) (*CountAssignmentsResponse, error)
+	ExportAssignments(*ExportAssignmentsRequest, grpc.ServerStreamingServer[Assignment]) error
+	PublishUserEvent(context.Context, *PublishUserEventRequest) (*PublishUserEventResponse, error)
+	mustEmbedUnimplementedAssignmentServiceServer()
+}
+
+// UnimplementedAssignmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAssignmentServiceServer struct{}
+
+func (UnimplementedAssignmentServiceServer) GetAssignment(context.Context, *GetAssignmentRequest) (*Assignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) ListAssignments(context.Context, *ListAssignmentsRequest) (*ListAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssignments not implemented")
+}
+func (UnimplementedAssignmentServiceServer) CountAssignments(context.Context, *CountAssignmentsRequest) (*CountAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountAssignments not implemented")
+}
+func (UnimplementedAssignmentServiceServer) ExportAssignments(*ExportAssignmentsRequest, grpc.ServerStreamingServer[Assignment]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAssignments not implemented")
+}
+func (UnimplementedAssignmentServiceServer) PublishUserEvent(context.Context, *PublishUserEventRequest) (*PublishUserEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishUserEvent not implemented")
+}
+func (UnimplementedAssignmentServiceServer) mustEmbedUnimplementedAssignmentServiceServer() {}
+func (UnimplementedAssignmentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAssignmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AssignmentServiceServer will
+// result in compilation errors.
+type UnsafeAssignmentServiceServer interface {
+	mustEmbedUnimplementedAssignmentServiceServer()
+}
+
+func RegisterAssignmentServiceServer(s grpc.ServiceRegistrar, srv AssignmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAssignmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AssignmentService_ServiceDesc, srv)
+}
+
+func _AssignmentService_GetAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).GetAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_GetAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).GetAssignment(ctx, req.(*GetAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_ListAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).ListAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_ListAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).ListAssignments(ctx, req.(*ListAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_CountAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).CountAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_CountAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).CountAssignments(ctx, req.(*CountAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_ExportAssignments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAssignmentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AssignmentServiceServer).ExportAssignments(m, &grpc.GenericServerStream[ExportAssignmentsRequest, Assignment]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AssignmentService_ExportAssignmentsServer = grpc.ServerStreamingServer[Assignment]
+
+func _AssignmentService_PublishUserEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishUserEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).PublishUserEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_PublishUserEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).PublishUserEvent(ctx, req.(*PublishUserEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AssignmentService_ServiceDesc is the grpc.ServiceDesc for AssignmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AssignmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "AssignmentService",
+	HandlerType: (*AssignmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAssignment",
+			Handler:    _AssignmentService_GetAssignment_Handler,
+		},
+		{
+			MethodName: "ListAssignments",
+			Handler:    _AssignmentService_ListAssignments_Handler,
+		},
+		{
+			MethodName: "CountAssignments",
+			Handler:    _AssignmentService_CountAssignments_Handler,
+		},
+		{
+			MethodName: "PublishUserEvent",
+			Handler:    _AssignmentService_PublishUserEvent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportAssignments",
+			Handler:       _AssignmentService_ExportAssignments_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "assignment_service.proto",
+}
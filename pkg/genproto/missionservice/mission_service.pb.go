@@ -9,6 +9,7 @@ package missionservice
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,11 +24,12 @@ const (
 type TriggerMissionEvent int32
 
 const (
-	TriggerMissionEvent_MISSION_EVENT_UNKNOWN           TriggerMissionEvent = 0
-	TriggerMissionEvent_MISSION_EVENT_CREATE_ASSIGNMENT TriggerMissionEvent = 1
-	TriggerMissionEvent_MISSION_EVENT_DONE_ASSIGNMENT   TriggerMissionEvent = 2
-	TriggerMissionEvent_MISSION_EVENT_UNDONE_ASSIGNMENT TriggerMissionEvent = 3
-	TriggerMissionEvent_MISSION_EVENT_DELETE_ASSIGNMENT TriggerMissionEvent = 4
+	TriggerMissionEvent_MISSION_EVENT_UNKNOWN                 TriggerMissionEvent = 0
+	TriggerMissionEvent_MISSION_EVENT_CREATE_ASSIGNMENT       TriggerMissionEvent = 1
+	TriggerMissionEvent_MISSION_EVENT_DONE_ASSIGNMENT         TriggerMissionEvent = 2
+	TriggerMissionEvent_MISSION_EVENT_UNDONE_ASSIGNMENT       TriggerMissionEvent = 3
+	TriggerMissionEvent_MISSION_EVENT_DELETE_ASSIGNMENT       TriggerMissionEvent = 4
+	TriggerMissionEvent_MISSION_EVENT_FOCUS_SESSION_COMPLETED TriggerMissionEvent = 5
 )
 
 // Enum value maps for TriggerMissionEvent.
@@ -38,13 +40,15 @@ var (
 		2: "MISSION_EVENT_DONE_ASSIGNMENT",
 		3: "MISSION_EVENT_UNDONE_ASSIGNMENT",
 		4: "MISSION_EVENT_DELETE_ASSIGNMENT",
+		5: "MISSION_EVENT_FOCUS_SESSION_COMPLETED",
 	}
 	TriggerMissionEvent_value = map[string]int32{
-		"MISSION_EVENT_UNKNOWN":           0,
-		"MISSION_EVENT_CREATE_ASSIGNMENT": 1,
-		"MISSION_EVENT_DONE_ASSIGNMENT":   2,
-		"MISSION_EVENT_UNDONE_ASSIGNMENT": 3,
-		"MISSION_EVENT_DELETE_ASSIGNMENT": 4,
+		"MISSION_EVENT_UNKNOWN":                 0,
+		"MISSION_EVENT_CREATE_ASSIGNMENT":       1,
+		"MISSION_EVENT_DONE_ASSIGNMENT":         2,
+		"MISSION_EVENT_UNDONE_ASSIGNMENT":       3,
+		"MISSION_EVENT_DELETE_ASSIGNMENT":       4,
+		"MISSION_EVENT_FOCUS_SESSION_COMPLETED": 5,
 	}
 )
 
@@ -75,18 +79,218 @@ func (TriggerMissionEvent) EnumDescriptor() ([]byte, []int) {
 	return file_mission_service_proto_rawDescGZIP(), []int{0}
 }
 
+type MissionEventCompletion int32
+
+const (
+	MissionEventCompletion_MISSION_EVENT_COMPLETION_UNSPECIFIED MissionEventCompletion = 0
+	MissionEventCompletion_MISSION_EVENT_COMPLETION_EARLY       MissionEventCompletion = 1
+	MissionEventCompletion_MISSION_EVENT_COMPLETION_LATE        MissionEventCompletion = 2
+)
+
+// Enum value maps for MissionEventCompletion.
+var (
+	MissionEventCompletion_name = map[int32]string{
+		0: "MISSION_EVENT_COMPLETION_UNSPECIFIED",
+		1: "MISSION_EVENT_COMPLETION_EARLY",
+		2: "MISSION_EVENT_COMPLETION_LATE",
+	}
+	MissionEventCompletion_value = map[string]int32{
+		"MISSION_EVENT_COMPLETION_UNSPECIFIED": 0,
+		"MISSION_EVENT_COMPLETION_EARLY":       1,
+		"MISSION_EVENT_COMPLETION_LATE":        2,
+	}
+)
+
+func (x MissionEventCompletion) Enum() *MissionEventCompletion {
+	p := new(MissionEventCompletion)
+	*p = x
+	return p
+}
+
+func (x MissionEventCompletion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MissionEventCompletion) Descriptor() protoreflect.EnumDescriptor {
+	return file_mission_service_proto_enumTypes[1].Descriptor()
+}
+
+func (MissionEventCompletion) Type() protoreflect.EnumType {
+	return &file_mission_service_proto_enumTypes[1]
+}
+
+func (x MissionEventCompletion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MissionEventCompletion.Descriptor instead.
+func (MissionEventCompletion) EnumDescriptor() ([]byte, []int) {
+	return file_mission_service_proto_rawDescGZIP(), []int{1}
+}
+
+type MissionEventAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsImportant bool                   `protobuf:"varint,1,opt,name=is_important,json=isImportant,proto3" json:"is_important,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Completion  MissionEventCompletion `protobuf:"varint,3,opt,name=completion,proto3,enum=MissionEventCompletion" json:"completion,omitempty"`
+}
+
+func (x *MissionEventAttributes) Reset() {
+	*x = MissionEventAttributes{}
+	mi := &file_mission_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissionEventAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissionEventAttributes) ProtoMessage() {}
+
+func (x *MissionEventAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_mission_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissionEventAttributes.ProtoReflect.Descriptor instead.
+func (*MissionEventAttributes) Descriptor() ([]byte, []int) {
+	return file_mission_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MissionEventAttributes) GetIsImportant() bool {
+	if x != nil {
+		return x.IsImportant
+	}
+	return false
+}
+
+func (x *MissionEventAttributes) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *MissionEventAttributes) GetCompletion() MissionEventCompletion {
+	if x != nil {
+		return x.Completion
+	}
+	return MissionEventCompletion_MISSION_EVENT_COMPLETION_UNSPECIFIED
+}
+
+type MissionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId    string                  `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Event      TriggerMissionEvent     `protobuf:"varint,2,opt,name=event,proto3,enum=TriggerMissionEvent" json:"event,omitempty"`
+	Amount     int32                   `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	OccurredAt *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	SourceId   string                  `protobuf:"bytes,5,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Attributes *MissionEventAttributes `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *MissionEvent) Reset() {
+	*x = MissionEvent{}
+	mi := &file_mission_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissionEvent) ProtoMessage() {}
+
+func (x *MissionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mission_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissionEvent.ProtoReflect.Descriptor instead.
+func (*MissionEvent) Descriptor() ([]byte, []int) {
+	return file_mission_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MissionEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *MissionEvent) GetEvent() TriggerMissionEvent {
+	if x != nil {
+		return x.Event
+	}
+	return TriggerMissionEvent_MISSION_EVENT_UNKNOWN
+}
+
+func (x *MissionEvent) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MissionEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *MissionEvent) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *MissionEvent) GetAttributes() *MissionEventAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 type TriggerMissionEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string              `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Event  TriggerMissionEvent `protobuf:"varint,2,opt,name=event,proto3,enum=TriggerMissionEvent" json:"event,omitempty"`
+	UserId     string                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Event      TriggerMissionEvent     `protobuf:"varint,2,opt,name=event,proto3,enum=TriggerMissionEvent" json:"event,omitempty"`
+	Amount     int32                   `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	EventId    string                  `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	OccurredAt *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	SourceId   string                  `protobuf:"bytes,6,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Attributes *MissionEventAttributes `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *TriggerMissionEventRequest) Reset() {
 	*x = TriggerMissionEventRequest{}
-	mi := &file_mission_service_proto_msgTypes[0]
+	mi := &file_mission_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -98,7 +302,7 @@ func (x *TriggerMissionEventRequest) String() string {
 func (*TriggerMissionEventRequest) ProtoMessage() {}
 
 func (x *TriggerMissionEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mission_service_proto_msgTypes[0]
+	mi := &file_mission_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,7 +315,7 @@ func (x *TriggerMissionEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerMissionEventRequest.ProtoReflect.Descriptor instead.
 func (*TriggerMissionEventRequest) Descriptor() ([]byte, []int) {
-	return file_mission_service_proto_rawDescGZIP(), []int{0}
+	return file_mission_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *TriggerMissionEventRequest) GetUserId() string {
@@ -128,6 +332,41 @@ func (x *TriggerMissionEventRequest) GetEvent() TriggerMissionEvent {
 	return TriggerMissionEvent_MISSION_EVENT_UNKNOWN
 }
 
+func (x *TriggerMissionEventRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TriggerMissionEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *TriggerMissionEventRequest) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *TriggerMissionEventRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *TriggerMissionEventRequest) GetAttributes() *MissionEventAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 type TriggerMissionEventResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -136,7 +375,7 @@ type TriggerMissionEventResponse struct {
 
 func (x *TriggerMissionEventResponse) Reset() {
 	*x = TriggerMissionEventResponse{}
-	mi := &file_mission_service_proto_msgTypes[1]
+	mi := &file_mission_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -148,7 +387,7 @@ func (x *TriggerMissionEventResponse) String() string {
 func (*TriggerMissionEventResponse) ProtoMessage() {}
 
 func (x *TriggerMissionEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mission_service_proto_msgTypes[1]
+	mi := &file_mission_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,44 +400,211 @@ func (x *TriggerMissionEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerMissionEventResponse.ProtoReflect.Descriptor instead.
 func (*TriggerMissionEventResponse) Descriptor() ([]byte, []int) {
-	return file_mission_service_proto_rawDescGZIP(), []int{1}
+	return file_mission_service_proto_rawDescGZIP(), []int{3}
+}
+
+type TriggerMissionEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Events        []TriggerMissionEvent `protobuf:"varint,2,rep,packed,name=events,proto3,enum=TriggerMissionEvent" json:"events,omitempty"`
+	MissionEvents []*MissionEvent       `protobuf:"bytes,3,rep,name=mission_events,json=missionEvents,proto3" json:"mission_events,omitempty"`
+}
+
+func (x *TriggerMissionEventsRequest) Reset() {
+	*x = TriggerMissionEventsRequest{}
+	mi := &file_mission_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerMissionEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerMissionEventsRequest) ProtoMessage() {}
+
+func (x *TriggerMissionEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mission_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerMissionEventsRequest.ProtoReflect.Descriptor instead.
+func (*TriggerMissionEventsRequest) Descriptor() ([]byte, []int) {
+	return file_mission_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TriggerMissionEventsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TriggerMissionEventsRequest) GetEvents() []TriggerMissionEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *TriggerMissionEventsRequest) GetMissionEvents() []*MissionEvent {
+	if x != nil {
+		return x.MissionEvents
+	}
+	return nil
+}
+
+type TriggerMissionEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TriggerMissionEventsResponse) Reset() {
+	*x = TriggerMissionEventsResponse{}
+	mi := &file_mission_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerMissionEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerMissionEventsResponse) ProtoMessage() {}
+
+func (x *TriggerMissionEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mission_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerMissionEventsResponse.ProtoReflect.Descriptor instead.
+func (*TriggerMissionEventsResponse) Descriptor() ([]byte, []int) {
+	return file_mission_service_proto_rawDescGZIP(), []int{5}
 }
 
 var File_mission_service_proto protoreflect.FileDescriptor
 
 var file_mission_service_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x61, 0x0a, 0x1a, 0x54, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a,
-	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0xc2, 0x01, 0x0a, 0x13, 0x54, 0x72,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x01, 0x0a, 0x16, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x02, 0x0a, 0x0c, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x1a, 0x54, 0x72,
 	0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f,
-	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52,
-	0x45, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10,
-	0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45,
-	0x4e, 0x54, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x5f, 0x41, 0x53, 0x53,
-	0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x49, 0x53,
-	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
-	0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x32, 0x62,
-	0x0a, 0x0e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x50, 0x0a, 0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69,
 	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x4d, 0x61, 0x73, 0x4c, 0x61, 0x7a, 0x75, 0x2f, 0x64, 0x65, 0x76, 0x2d, 0x6f, 0x70, 0x73,
-	0x2d, 0x70, 0x6f, 0x72, 0x74, 0x6f, 0x2f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x1b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x0e, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x1e, 0x0a, 0x1c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a,
+	0xed, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47,
+	0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x5f, 0x41, 0x53,
+	0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x44, 0x4f,
+	0x4e, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12,
+	0x23, 0x0a, 0x1f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45,
+	0x4e, 0x54, 0x10, 0x04, 0x12, 0x29, 0x0a, 0x25, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x43, 0x55, 0x53, 0x5f, 0x53, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x05, 0x2a,
+	0x89, 0x01, 0x0a, 0x16, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x24, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x02, 0x32, 0xb7, 0x01, 0x0a, 0x0e,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50,
+	0x0a, 0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x14, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x73, 0x4c, 0x61, 0x7a, 0x75, 0x2f, 0x64, 0x65, 0x76, 0x2d,
+	0x6f, 0x70, 0x73, 0x2d, 0x70, 0x6f, 0x72, 0x74, 0x6f, 0x2f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -213,22 +619,39 @@ func file_mission_service_proto_rawDescGZIP() []byte {
 	return file_mission_service_proto_rawDescData
 }
 
-var file_mission_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mission_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mission_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mission_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_mission_service_proto_goTypes = []any{
-	(TriggerMissionEvent)(0),            // 0: TriggerMissionEvent
-	(*TriggerMissionEventRequest)(nil),  // 1: TriggerMissionEventRequest
-	(*TriggerMissionEventResponse)(nil), // 2: TriggerMissionEventResponse
+	(TriggerMissionEvent)(0),             // 0: TriggerMissionEvent
+	(MissionEventCompletion)(0),          // 1: MissionEventCompletion
+	(*MissionEventAttributes)(nil),       // 2: MissionEventAttributes
+	(*MissionEvent)(nil),                 // 3: MissionEvent
+	(*TriggerMissionEventRequest)(nil),   // 4: TriggerMissionEventRequest
+	(*TriggerMissionEventResponse)(nil),  // 5: TriggerMissionEventResponse
+	(*TriggerMissionEventsRequest)(nil),  // 6: TriggerMissionEventsRequest
+	(*TriggerMissionEventsResponse)(nil), // 7: TriggerMissionEventsResponse
+	(*timestamppb.Timestamp)(nil),        // 8: google.protobuf.Timestamp
 }
 var file_mission_service_proto_depIdxs = []int32{
-	0, // 0: TriggerMissionEventRequest.event:type_name -> TriggerMissionEvent
-	1, // 1: MissionService.TriggerMissionEvent:input_type -> TriggerMissionEventRequest
-	2, // 2: MissionService.TriggerMissionEvent:output_type -> TriggerMissionEventResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8,  // 0: MissionEventAttributes.due_date:type_name -> google.protobuf.Timestamp
+	1,  // 1: MissionEventAttributes.completion:type_name -> MissionEventCompletion
+	0,  // 2: MissionEvent.event:type_name -> TriggerMissionEvent
+	8,  // 3: MissionEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: MissionEvent.attributes:type_name -> MissionEventAttributes
+	0,  // 5: TriggerMissionEventRequest.event:type_name -> TriggerMissionEvent
+	8,  // 6: TriggerMissionEventRequest.occurred_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: TriggerMissionEventRequest.attributes:type_name -> MissionEventAttributes
+	0,  // 8: TriggerMissionEventsRequest.events:type_name -> TriggerMissionEvent
+	3,  // 9: TriggerMissionEventsRequest.mission_events:type_name -> MissionEvent
+	4,  // 10: MissionService.TriggerMissionEvent:input_type -> TriggerMissionEventRequest
+	6,  // 11: MissionService.TriggerMissionEvents:input_type -> TriggerMissionEventsRequest
+	5,  // 12: MissionService.TriggerMissionEvent:output_type -> TriggerMissionEventResponse
+	7,  // 13: MissionService.TriggerMissionEvents:output_type -> TriggerMissionEventsResponse
+	12, // [12:14] is the sub-list for method output_type
+	10, // [10:12] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_mission_service_proto_init() }
@@ -241,8 +664,8 @@ func file_mission_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mission_service_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MissionService_TriggerMissionEvent_FullMethodName = "/MissionService/TriggerMissionEvent"
+	MissionService_TriggerMissionEvent_FullMethodName  = "/MissionService/TriggerMissionEvent"
+	MissionService_TriggerMissionEvents_FullMethodName = "/MissionService/TriggerMissionEvents"
 )
 
 // MissionServiceClient is the client API for MissionService service.
@@ -27,6 +28,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MissionServiceClient interface {
 	TriggerMissionEvent(ctx context.Context, in *TriggerMissionEventRequest, opts ...grpc.CallOption) (*TriggerMissionEventResponse, error)
+	TriggerMissionEvents(ctx context.Context, in *TriggerMissionEventsRequest, opts ...grpc.CallOption) (*TriggerMissionEventsResponse, error)
 }
 
 type missionServiceClient struct {
@@ -47,11 +49,22 @@ func (c *missionServiceClient) TriggerMissionEvent(ctx context.Context, in *Trig
 	return out, nil
 }
 
+func (c *missionServiceClient) TriggerMissionEvents(ctx context.Context, in *TriggerMissionEventsRequest, opts ...grpc.CallOption) (*TriggerMissionEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerMissionEventsResponse)
+	err := c.cc.Invoke(ctx, MissionService_TriggerMissionEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MissionServiceServer is the server API for MissionService service.
 // All implementations must embed UnimplementedMissionServiceServer
 // for forward compatibility.
 type MissionServiceServer interface {
 	TriggerMissionEvent(context.Context, *TriggerMissionEventRequest) (*TriggerMissionEventResponse, error)
+	TriggerMissionEvents(context.Context, *TriggerMissionEventsRequest) (*TriggerMissionEventsResponse, error)
 	mustEmbedUnimplementedMissionServiceServer()
 }
 
@@ -65,6 +78,9 @@ type UnimplementedMissionServiceServer struct{}
 func (UnimplementedMissionServiceServer) TriggerMissionEvent(context.Context, *TriggerMissionEventRequest) (*TriggerMissionEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerMissionEvent not implemented")
 }
+func (UnimplementedMissionServiceServer) TriggerMissionEvents(context.Context, *TriggerMissionEventsRequest) (*TriggerMissionEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerMissionEvents not implemented")
+}
 func (UnimplementedMissionServiceServer) mustEmbedUnimplementedMissionServiceServer() {}
 func (UnimplementedMissionServiceServer) testEmbeddedByValue()                        {}
 
@@ -104,6 +120,24 @@ func _MissionService_TriggerMissionEvent_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MissionService_TriggerMissionEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerMissionEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MissionServiceServer).TriggerMissionEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MissionService_TriggerMissionEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MissionServiceServer).TriggerMissionEvents(ctx, req.(*TriggerMissionEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MissionService_ServiceDesc is the grpc.ServiceDesc for MissionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +149,10 @@ var MissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TriggerMissionEvent",
 			Handler:    _MissionService_TriggerMissionEvent_Handler,
 		},
+		{
+			MethodName: "TriggerMissionEvents",
+			Handler:    _MissionService_TriggerMissionEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mission_service.proto",
@@ -57,6 +57,12 @@ func (m *AuthMiddleware) Auth(next http.Handler) http.Handler {
 			return
 		}
 
+		if audience, _ := token.Claims.GetAudience(); len(audience) > 0 {
+			span.SetAttributes(attribute.String("clientError.message", "token audience is not allowed"))
+			m.responseWriter.WriteUnauthorizedResponse(ctx, w)
+			return
+		}
+
 		ctx = context.WithValue(ctx, util.AuthContextKey, token.Claims)
 		r = r.WithContext(ctx)
 
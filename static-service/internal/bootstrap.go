@@ -18,7 +18,7 @@ func bootstrap(config config, logger *monitoring.Logger) *server.HttpServer {
 	tracer := otel.Tracer(config.serviceName)
 	responseWriter := util.NewResponseWriter(tracer)
 	handlerTracer := util.NewHandlerTracer(tracer)
-	httpHandler := NewHttpHandler(tracer, responseWriter, handlerTracer, s3Client, config.aws.s3.bucketNames)
+	httpHandler := NewHttpHandler(tracer, responseWriter, handlerTracer, s3Client, config.aws.s3.bucketNames, config.attachmentSigningKey)
 
 	return server.NewHttpServer(server.HttpServerConfig{
 		Port:        config.port,
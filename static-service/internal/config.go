@@ -13,6 +13,8 @@ import (
 
 type bucketNames struct {
 	profilePictures string
+	attachments     string
+	missionImages   string
 }
 
 type s3Config struct {
@@ -26,10 +28,11 @@ type AwsConfig struct {
 }
 
 type config struct {
-	port        int
-	serviceName string
-	otlpDomain  string
-	aws         AwsConfig
+	port                 int
+	serviceName          string
+	otlpDomain           string
+	attachmentSigningKey []byte
+	aws                  AwsConfig
 }
 
 func getConfig(ctx context.Context) (config, error) {
@@ -43,16 +46,24 @@ func getConfig(ctx context.Context) (config, error) {
 		return config{}, fmt.Errorf("failed to get S3 config: %w", err)
 	}
 
+	attachmentSigningKey := os.Getenv("ATTACHMENT_SIGNING_KEY")
+	if attachmentSigningKey == "" {
+		return config{}, fmt.Errorf("ATTACHMENT_SIGNING_KEY is required")
+	}
+
 	return config{
-		port:        port,
-		otlpDomain:  os.Getenv("OTLP_DOMAIN"),
-		serviceName: "auth-service",
+		port:                 port,
+		otlpDomain:           os.Getenv("OTLP_DOMAIN"),
+		attachmentSigningKey: []byte(attachmentSigningKey),
+		serviceName:          "auth-service",
 		aws: AwsConfig{
 			awsConfig: awsConfig,
 			s3: s3Config{
 				enpoint: os.Getenv("S3_ENDPOINT"),
 				bucketNames: bucketNames{
 					profilePictures: os.Getenv("S3_BUCKET_PROFILE_PICTURES"),
+					attachments:     os.Getenv("S3_BUCKET_ATTACHMENTS"),
+					missionImages:   os.Getenv("S3_BUCKET_MISSION_IMAGES"),
 				},
 			},
 		},
@@ -9,15 +9,20 @@ import (
 	"github.com/MasLazu/dev-ops-porto/pkg/util"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
+const attachmentTokenAudience = "attachments"
+
 type HttpHandler struct {
-	tracer         trace.Tracer
-	responseWriter *util.ResponseWriter
-	handlerTracer  *util.HandlerTracer
-	s3Client       *s3.Client
-	bucketNames    bucketNames
+	tracer               trace.Tracer
+	responseWriter       *util.ResponseWriter
+	handlerTracer        *util.HandlerTracer
+	s3Client             *s3.Client
+	bucketNames          bucketNames
+	attachmentSigningKey []byte
 }
 
 func NewHttpHandler(
@@ -26,18 +31,22 @@ func NewHttpHandler(
 	handlerTracer *util.HandlerTracer,
 	s3Client *s3.Client,
 	bucketNames bucketNames,
+	attachmentSigningKey []byte,
 ) *HttpHandler {
 	return &HttpHandler{
-		tracer:         tracer,
-		responseWriter: responseWriter,
-		handlerTracer:  handlerTracer,
-		s3Client:       s3Client,
-		bucketNames:    bucketNames,
+		tracer:               tracer,
+		responseWriter:       responseWriter,
+		handlerTracer:        handlerTracer,
+		s3Client:             s3Client,
+		bucketNames:          bucketNames,
+		attachmentSigningKey: attachmentSigningKey,
 	}
 }
 
 func (h *HttpHandler) setupRoutes(c *chi.Mux) http.Handler {
 	c.Get("/health", h.HealthCheck)
+	c.Get("/attachments/*", h.ServeAttachment)
+	c.Get("/missions/*", h.ServeMissionImage)
 	c.Get("/*", h.ServeStaticFile)
 
 	return c
@@ -54,12 +63,23 @@ func (h *HttpHandler) ServeStaticFile(w http.ResponseWriter, r *http.Request) {
 	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.ServeStaticFile")
 	defer span.End()
 
+	h.serveImage(w, r.WithContext(ctx), h.bucketNames.profilePictures)
+}
+
+func (h *HttpHandler) ServeMissionImage(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.ServeMissionImage")
+	defer span.End()
+
+	h.serveImage(w, r.WithContext(ctx), h.bucketNames.missionImages)
+}
+
+func (h *HttpHandler) serveImage(w http.ResponseWriter, r *http.Request, bucket string) {
 	key := chi.URLParam(r, "*")
 	key = path.Clean(key)
 	key = strings.TrimPrefix(key, "/")
 
-	result, err := h.s3Client.GetObject(ctx, &s3.GetObjectInput{
-		Bucket: &h.bucketNames.profilePictures,
+	result, err := h.s3Client.GetObject(r.Context(), &s3.GetObjectInput{
+		Bucket: &bucket,
 		Key:    &key,
 	})
 	if err != nil {
@@ -82,3 +102,59 @@ func (h *HttpHandler) ServeStaticFile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+func (h *HttpHandler) ServeAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.handlerTracer.TraceHttpHandler(r, "HttpHandler.ServeAttachment")
+	defer span.End()
+
+	key := chi.URLParam(r, "*")
+	key = path.Clean(key)
+	key = strings.TrimPrefix(key, "/")
+
+	claims := &jwt.RegisteredClaims{}
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		return h.attachmentSigningKey, nil
+	}
+	_, err := jwt.ParseWithClaims(r.URL.Query().Get("token"), claims, keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithAudience(attachmentTokenAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || claims.Subject != key {
+		if err != nil {
+			span.SetAttributes(attribute.String("clientError.message", err.Error()))
+		}
+		h.responseWriter.WriteErrorResponse(ctx, w, http.StatusForbidden, http.StatusText(http.StatusForbidden))
+		return
+	}
+
+	result, err := h.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &h.bucketNames.attachments,
+		Key:    &key,
+	})
+	if err != nil {
+		http.Error(w, "File Not Found", http.StatusNotFound)
+		return
+	}
+	defer result.Body.Close()
+
+	if result.ContentType != nil {
+		w.Header().Set("Content-Type", *result.ContentType)
+	} else {
+		w.Header().Set("Content-Type", "application/octet-stream")
+	}
+
+	if result.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", *result.ContentDisposition)
+	} else {
+		w.Header().Set("Content-Disposition", "attachment")
+	}
+
+	w.Header().Set("Cache-Control", "private, max-age=900")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	if _, err := io.Copy(w, result.Body); err != nil {
+		http.Error(w, "Error serving file", http.StatusInternalServerError)
+		return
+	}
+}
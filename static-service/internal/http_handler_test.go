@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/MasLazu/dev-ops-porto/pkg/util"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	testAttachmentBucket = "attachments"
+	testAttachmentKey    = "0b7f1a52-6d1e-4c55-9b8e-2f0f4f7c1a11/8d3c7e9a-notes.pdf"
+	testAttachmentBody   = "%PDF-1.7 attachment"
+)
+
+var testAttachmentSigningKey = []byte("attachmentsecret")
+
+func newAttachmentTestServer(t *testing.T) (*httptest.Server, *int) {
+	t.Helper()
+
+	objectRequests := 0
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		objectRequests++
+		if r.URL.Path != "/"+testAttachmentBucket+"/"+testAttachmentKey {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", `attachment; filename="notes.pdf"`)
+		io.WriteString(w, testAttachmentBody)
+	}))
+	t.Cleanup(storage.Close)
+
+	s3Client := s3.New(s3.Options{
+		Region:       "us-west-2",
+		BaseEndpoint: aws.String(storage.URL),
+		UsePathStyle: true,
+		Credentials:  aws.AnonymousCredentials{},
+	})
+
+	tracer := noop.NewTracerProvider().Tracer("test")
+	handler := NewHttpHandler(
+		tracer,
+		util.NewResponseWriter(tracer),
+		util.NewHandlerTracer(tracer),
+		s3Client,
+		bucketNames{attachments: testAttachmentBucket},
+		testAttachmentSigningKey,
+	)
+
+	server := httptest.NewServer(handler.setupRoutes(chi.NewRouter()))
+	t.Cleanup(server.Close)
+
+	return server, &objectRequests
+}
+
+func newAttachmentTestToken(t *testing.T, method jwt.SigningMethod, key []byte, claims jwt.RegisteredClaims) string {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestServeAttachment(t *testing.T) {
+	now := time.Now()
+	validClaims := func() jwt.RegisteredClaims {
+		return jwt.RegisteredClaims{
+			Subject:   testAttachmentKey,
+			Audience:  jwt.ClaimStrings{attachmentTokenAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(15 * time.Minute)),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		token  func() string
+		status int
+	}{
+		{
+			name: "valid token",
+			token: func() string {
+				return newAttachmentTestToken(t, jwt.SigningMethodHS256, testAttachmentSigningKey, validClaims())
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "expired token",
+			token: func() string {
+				claims := validClaims()
+				claims.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Minute))
+				return newAttachmentTestToken(t, jwt.SigningMethodHS256, testAttachmentSigningKey, claims)
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name: "token without expiry",
+			token: func() string {
+				claims := validClaims()
+				claims.ExpiresAt = nil
+				return newAttachmentTestToken(t, jwt.SigningMethodHS256, testAttachmentSigningKey, claims)
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name: "wrong audience",
+			token: func() string {
+				claims := validClaims()
+				claims.Audience = jwt.ClaimStrings{"api"}
+				return newAttachmentTestToken(t, jwt.SigningMethodHS256, testAttachmentSigningKey, claims)
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name: "missing audience",
+			token: func() string {
+				claims := validClaims()
+				claims.Audience = nil
+				return newAttachmentTestToken(t, jwt.SigningMethodHS256, testAttachmentSigningKey, claims)
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name: "token for another user's key",
+			token: func() string {
+				claims := validClaims()
+				claims.Subject = "6a3c0f0e-1d2b-4f3a-8c4d-5e6f7a8b9c0d/8d3c7e9a-notes.pdf"
+				return newAttachmentTestToken(t, jwt.SigningMethodHS256, testAttachmentSigningKey, claims)
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name: "wrong signing key",
+			token: func() string {
+				return newAttachmentTestToken(t, jwt.SigningMethodHS256, []byte("jwtsecret"), validClaims())
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name: "unexpected signing method",
+			token: func() string {
+				return newAttachmentTestToken(t, jwt.SigningMethodHS512, testAttachmentSigningKey, validClaims())
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "missing token",
+			token:  func() string { return "" },
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, objectRequests := newAttachmentTestServer(t)
+
+			query := url.Values{"token": {tt.token()}}
+			res, err := http.Get(server.URL + "/attachments/" + testAttachmentKey + "?" + query.Encode())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != tt.status {
+				t.Fatalf("status = %d, want %d", res.StatusCode, tt.status)
+			}
+
+			if tt.status != http.StatusOK {
+				if *objectRequests != 0 {
+					t.Errorf("storage was queried %d times for a rejected token", *objectRequests)
+				}
+				return
+			}
+
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(body) != testAttachmentBody {
+				t.Errorf("body = %q, want %q", body, testAttachmentBody)
+			}
+			if got := res.Header.Get("Content-Type"); got != "application/pdf" {
+				t.Errorf("Content-Type = %q, want application/pdf", got)
+			}
+			if got := res.Header.Get("Cache-Control"); got != "private, max-age=900" {
+				t.Errorf("Cache-Control = %q, want private, max-age=900", got)
+			}
+			if got := res.Header.Get("X-Content-Type-Options"); got != "nosniff" {
+				t.Errorf("X-Content-Type-Options = %q, want nosniff", got)
+			}
+		})
+	}
+}